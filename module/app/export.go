@@ -160,7 +160,7 @@ func (app *Gravity) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []st
 	counter := int16(0)
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[1:])
+		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, found := app.stakingKeeper.GetValidator(ctx, addr)
 		if !found {
 			panic("expected validator, not found")
@@ -193,4 +193,9 @@ func (app *Gravity) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []st
 			return false
 		},
 	)
+
+	/* Handle gravity state. */
+
+	// reset the cosmos heights of outgoing txs, counters and quarantined deposits
+	app.gravityKeeper.PrepForZeroHeightGenesis(ctx)
 }
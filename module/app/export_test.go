@@ -0,0 +1,203 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gravitykeeper "github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// zeroHeightTestChain drives a single validator gravity app block by block
+type zeroHeightTestChain struct {
+	t    *testing.T
+	app  *Gravity
+	cons sdk.ConsAddress
+	time time.Time
+}
+
+func newZeroHeightTestApp() *Gravity {
+	return NewGravityApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
+}
+
+// block runs a block in which deliver is called with the deliver context
+// between the begin and end blockers
+func (c *zeroHeightTestChain) block(deliver func(ctx sdk.Context)) {
+	c.time = c.time.Add(5 * time.Second)
+	header := tmproto.Header{Height: c.app.LastBlockHeight() + 1, Time: c.time, ProposerAddress: c.cons}
+	c.app.BeginBlock(abci.RequestBeginBlock{
+		Header: header,
+		LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{{
+			Validator:       abci.Validator{Address: c.cons, Power: 1},
+			SignedLastBlock: true,
+		}}},
+	})
+	if deliver != nil {
+		deliver(c.app.BaseApp.NewContext(false, header))
+	}
+	c.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	c.app.Commit()
+}
+
+func (c *zeroHeightTestChain) ctx() sdk.Context {
+	return c.app.BaseApp.NewContext(true, tmproto.Header{Height: c.app.LastBlockHeight(), Time: c.time})
+}
+
+func TestZeroHeightExportKeepsBridging(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	consKey := ed25519.GenPrivKey()
+	accKey := secp256k1.GenPrivKey()
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	acc := sdk.AccAddress(accKey.PubKey().Address())
+	val := sdk.ValAddress(acc)
+	bondAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	denom := gravitytypes.NewERC20Token(0, tokenContract).GravityCoin().Denom
+
+	// a genesis with an unbonded validator, bonded by the staking genesis
+	genesis := NewDefaultGenesisState()
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{authtypes.NewBaseAccount(acc, accKey.PubKey(), 0, 0)})
+	genesis[authtypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(authGenesis)
+
+	pkAny, err := codectypes.NewAnyWithValue(consKey.PubKey())
+	require.NoError(t, err)
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{{
+		OperatorAddress:   val.String(),
+		ConsensusPubkey:   pkAny,
+		Status:            stakingtypes.Unbonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   bondAmt.ToDec(),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.OneDec()),
+		MinSelfDelegation: sdk.OneInt(),
+	}}, []stakingtypes.Delegation{stakingtypes.NewDelegation(acc, val, bondAmt.ToDec())})
+	genesis[stakingtypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(stakingGenesis)
+
+	// enough supply for the inflation to pay commission every block
+	accAmt := sdk.NewInt(1000000000000)
+	balances := []banktypes.Balance{
+		{Address: acc.String(), Coins: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, accAmt))},
+		{Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(), Coins: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))},
+	}
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultParams(), balances, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, accAmt.Add(bondAmt))), nil)
+	genesis[banktypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(bankGenesis)
+
+	gravityGenesis := gravitytypes.DefaultGenesisState()
+	gravityGenesis.DelegateKeys = []*gravitytypes.MsgDelegateKeys{{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: acc.String(),
+		EthereumAddress:     crypto.PubkeyToAddress(ethKey.PublicKey).Hex(),
+	}}
+	genesis[gravitytypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(gravityGenesis)
+
+	stateBytes, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	chain := &zeroHeightTestChain{t: t, app: newZeroHeightTestApp(), cons: sdk.ConsAddress(consKey.PubKey().Address()), time: time.Unix(1600000000, 0).UTC()}
+	chain.app.InitChain(abci.RequestInitChain{Time: chain.time, ConsensusParams: simapp.DefaultConsensusParams, AppStateBytes: stateBytes})
+	chain.app.Commit()
+
+	deposit := func(c *zeroHeightTestChain, nonce, ethereumHeight uint64) func(ctx sdk.Context) {
+		return func(ctx sdk.Context) {
+			event, err := gravitytypes.PackEvent(&gravitytypes.SendToCosmosEvent{
+				EventNonce:     nonce,
+				TokenContract:  tokenContract,
+				Amount:         sdk.NewInt(1000),
+				EthereumSender: crypto.PubkeyToAddress(ethKey.PublicKey).Hex(),
+				CosmosReceiver: acc.String(),
+				EthereumHeight: ethereumHeight,
+			})
+			require.NoError(t, err)
+			_, err = gravitykeeper.NewMsgServerImpl(c.app.gravityKeeper).SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &gravitytypes.MsgSubmitEthereumEvent{Event: event, Signer: acc.String()})
+			require.NoError(t, err)
+		}
+	}
+	sendToEthereum := func(c *zeroHeightTestChain) func(ctx sdk.Context) {
+		return func(ctx sdk.Context) {
+			msgServer := gravitykeeper.NewMsgServerImpl(c.app.gravityKeeper)
+			_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &gravitytypes.MsgSendToEthereum{
+				Sender:            acc.String(),
+				EthereumRecipient: crypto.PubkeyToAddress(ethKey.PublicKey).Hex(),
+				Amount:            sdk.NewInt64Coin(denom, 100),
+				BridgeFee:         sdk.NewInt64Coin(denom, 1),
+			})
+			require.NoError(t, err)
+			_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), &gravitytypes.MsgRequestBatchTx{Denom: denom, Signer: acc.String()})
+			require.NoError(t, err)
+		}
+	}
+
+	// bridge on the first chain
+	for i := 0; i < 5; i++ {
+		chain.block(nil)
+	}
+	chain.block(deposit(chain, 1, 100))
+	chain.block(sendToEthereum(chain))
+	for chain.app.LastBlockHeight() < 30 {
+		chain.block(nil)
+	}
+	params := chain.app.gravityKeeper.GetParams(chain.ctx())
+	observed := chain.app.gravityKeeper.GetLastObservedEthereumBlockHeight(chain.ctx())
+	require.EqualValues(t, 100, observed.EthereumHeight)
+	require.Equal(t, sdk.NewInt(899), chain.app.bankKeeper.GetBalance(chain.ctx(), acc, denom).Amount)
+
+	exported, err := chain.app.ExportAppStateAndValidators(true, nil)
+	require.NoError(t, err)
+	require.Zero(t, exported.Height)
+
+	// every cosmos height of the gravity state is rebased
+	var exportedGenesis GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &exportedGenesis))
+	var exportedGravity gravitytypes.GenesisState
+	encCfg.Marshaler.MustUnmarshalJSON(exportedGenesis[gravitytypes.ModuleName], &exportedGravity)
+	require.NoError(t, exportedGravity.UnpackInterfaces(encCfg.InterfaceRegistry))
+	require.Len(t, exportedGravity.OutgoingTxs, 2)
+	for _, any := range exportedGravity.OutgoingTxs {
+		otx, err := gravitytypes.UnpackOutgoingTx(any)
+		require.NoError(t, err)
+		require.Zero(t, otx.GetCosmosHeight())
+	}
+	projected := 100 + (30-observed.CosmosHeight)*params.AverageBlockTime/params.AverageEthereumBlockTime
+	require.Equal(t, &gravitytypes.LatestEthereumBlockHeight{EthereumHeight: projected}, exportedGravity.LastObservedEthereumHeight)
+	require.Zero(t, exportedGravity.LastSlashedOutgoingTxBlockHeight)
+
+	// restart from the export at height zero and keep bridging
+	restarted := &zeroHeightTestChain{t: t, app: newZeroHeightTestApp(), cons: chain.cons, time: chain.time}
+	restarted.app.InitChain(abci.RequestInitChain{Time: restarted.time, ConsensusParams: exported.ConsensusParams, AppStateBytes: exported.AppState})
+	restarted.app.Commit()
+
+	restarted.block(nil)
+	restarted.block(sendToEthereum(restarted))
+	otx := restarted.app.gravityKeeper.GetOutgoingTx(restarted.ctx(), gravitytypes.MakeBatchTxKey(common.HexToAddress(tokenContract), 2))
+	require.NotNil(t, otx)
+	batch := otx.(*gravitytypes.BatchTx)
+	require.EqualValues(t, 2, batch.BatchNonce)
+	require.EqualValues(t, restarted.app.LastBlockHeight(), batch.Height)
+	blocksToAdd := params.TargetEthTxTimeout / params.AverageEthereumBlockTime
+	require.Equal(t, projected+batch.Height*params.AverageBlockTime/params.AverageEthereumBlockTime+blocksToAdd, batch.Timeout)
+
+	restarted.block(deposit(restarted, 2, 200))
+	require.Equal(t, sdk.NewInt(1798), restarted.app.bankKeeper.GetBalance(restarted.ctx(), acc, denom).Amount)
+	for restarted.app.LastBlockHeight() < 10 {
+		restarted.block(nil)
+	}
+}
@@ -0,0 +1,379 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	flagTxFile            = "tx-file"
+	flagConfirmationsFile = "confirmations-file"
+	flagSignerSetFile     = "signer-set-file"
+	flagSignerSetNonce    = "signer-set-nonce"
+	flagGravityID         = "gravity-id"
+)
+
+// CheckpointCmd returns the debug commands to verify outgoing tx checkpoints
+// and their confirmations
+func CheckpointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Verify outgoing tx checkpoints and their ethereum signatures",
+		Long: `Compute the checkpoint of a signer set, batch or contract call tx and verify the
+ethereum signatures confirming it against a signer set.
+
+The outgoing tx, confirmations and signer set are queried from a node unless they are read
+from files in the JSON format the corresponding gravity query commands output. Without a
+node --gravity-id has to be passed as well.
+
+By default signatures on a signer set tx are checked against the previous signer set and
+signatures on batch and contract call txs against the last signer set observed on the
+gravity contract, which is the one the contract checks them against.
+`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		checkpointSignerSetTxCmd(),
+		checkpointBatchTxCmd(),
+		checkpointContractCallTxCmd(),
+	)
+
+	return cmd
+}
+
+func checkpointSignerSetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set [nonce]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Verify the checkpoint and confirmations of a signer set tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res := &types.SignerSetTxResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagTxFile, res, func() (proto.Message, error) {
+				nonce, err := parseNonceArg(args, 0)
+				if err != nil {
+					return nil, err
+				}
+				if nonce == 0 {
+					return queryClient.LatestSignerSetTx(cmd.Context(), &types.LatestSignerSetTxRequest{})
+				}
+				return queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce})
+			}); err != nil {
+				return err
+			}
+			if res.SignerSet == nil {
+				return fmt.Errorf("signer set tx not found")
+			}
+
+			confirmations := &types.SignerSetTxConfirmationsResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagConfirmationsFile, confirmations, func() (proto.Message, error) {
+				return queryClient.SignerSetTxConfirmations(cmd.Context(), &types.SignerSetTxConfirmationsRequest{SignerSetNonce: res.SignerSet.Nonce})
+			}); err != nil {
+				return err
+			}
+
+			var confs []types.EthereumTxConfirmation
+			for _, conf := range confirmations.Signatures {
+				confs = append(confs, conf)
+			}
+
+			// signer set updates are signed by the signer set they replace
+			signerSetNonce := res.SignerSet.Nonce
+			if signerSetNonce > 1 {
+				signerSetNonce--
+			}
+			return runCheckpoint(cmd, clientCtx, res.SignerSet, confs, signerSetNonce)
+		},
+	}
+
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+func checkpointBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [token-contract] [nonce]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Verify the checkpoint and confirmations of a batch tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res := &types.BatchTxResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagTxFile, res, func() (proto.Message, error) {
+				if len(args) != 2 {
+					return nil, fmt.Errorf("token contract and nonce are required without --%s", flagTxFile)
+				}
+				if !common.IsHexAddress(args[0]) {
+					return nil, fmt.Errorf("%s not a valid contract address", args[0])
+				}
+				nonce, err := parseNonceArg(args, 1)
+				if err != nil {
+					return nil, err
+				}
+				return queryClient.BatchTx(cmd.Context(), &types.BatchTxRequest{
+					TokenContract: common.HexToAddress(args[0]).Hex(),
+					BatchNonce:    nonce,
+				})
+			}); err != nil {
+				return err
+			}
+			if res.Batch == nil {
+				return fmt.Errorf("batch tx not found")
+			}
+
+			confirmations := &types.BatchTxConfirmationsResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagConfirmationsFile, confirmations, func() (proto.Message, error) {
+				return queryClient.BatchTxConfirmations(cmd.Context(), &types.BatchTxConfirmationsRequest{
+					TokenContract: res.Batch.TokenContract,
+					BatchNonce:    res.Batch.BatchNonce,
+				})
+			}); err != nil {
+				return err
+			}
+
+			var confs []types.EthereumTxConfirmation
+			for _, conf := range confirmations.Signatures {
+				confs = append(confs, conf)
+			}
+
+			return runCheckpoint(cmd, clientCtx, res.Batch, confs, 0)
+		},
+	}
+
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+func checkpointContractCallTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-call [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Verify the checkpoint and confirmations of a contract call tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res := &types.ContractCallTxResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagTxFile, res, func() (proto.Message, error) {
+				if len(args) != 2 {
+					return nil, fmt.Errorf("invalidation scope and nonce are required without --%s", flagTxFile)
+				}
+				scope, err := hexutil.Decode(ensureHexPrefix(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				nonce, err := parseNonceArg(args, 1)
+				if err != nil {
+					return nil, err
+				}
+				return queryClient.ContractCallTx(cmd.Context(), &types.ContractCallTxRequest{
+					InvalidationScope: scope,
+					InvalidationNonce: nonce,
+				})
+			}); err != nil {
+				return err
+			}
+			if res.LogicCall == nil {
+				return fmt.Errorf("contract call tx not found")
+			}
+
+			confirmations := &types.ContractCallTxConfirmationsResponse{}
+			if err := readOrQuery(cmd, clientCtx.Codec, flagConfirmationsFile, confirmations, func() (proto.Message, error) {
+				return queryClient.ContractCallTxConfirmations(cmd.Context(), &types.ContractCallTxConfirmationsRequest{
+					InvalidationScope: res.LogicCall.InvalidationScope,
+					InvalidationNonce: res.LogicCall.InvalidationNonce,
+				})
+			}); err != nil {
+				return err
+			}
+
+			var confs []types.EthereumTxConfirmation
+			for _, conf := range confirmations.Signatures {
+				confs = append(confs, conf)
+			}
+
+			return runCheckpoint(cmd, clientCtx, res.LogicCall, confs, 0)
+		},
+	}
+
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+func addCheckpointFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTxFile, "", "Read the outgoing tx from a JSON file instead of querying it")
+	cmd.Flags().String(flagConfirmationsFile, "", "Read the confirmations from a JSON file instead of querying them")
+	cmd.Flags().String(flagSignerSetFile, "", "Read the signer set tx to verify against from a JSON file instead of querying it")
+	cmd.Flags().Uint64(flagSignerSetNonce, 0, "Nonce of the signer set tx to verify against")
+	cmd.Flags().String(flagGravityID, "", "The gravity id, queried from the module params if omitted")
+	flags.AddQueryFlagsToCmd(cmd)
+}
+
+// readOrQuery unmarshals the JSON file given by the flag into res, or queries
+// res if the flag is not set
+func readOrQuery(cmd *cobra.Command, cdc codec.JSONCodec, flag string, res proto.Message, query func() (proto.Message, error)) error {
+	if file, _ := cmd.Flags().GetString(flag); file != "" {
+		bz, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		return cdc.UnmarshalJSON(bz, res)
+	}
+
+	queried, err := query()
+	if err != nil {
+		return err
+	}
+	proto.Merge(res, queried)
+	return nil
+}
+
+func parseNonceArg(args []string, i int) (uint64, error) {
+	if len(args) <= i {
+		return 0, nil
+	}
+	return strconv.ParseUint(args[i], 10, 64)
+}
+
+// runCheckpoint prints the checkpoint of the outgoing tx and verifies the
+// confirmations against the signer set tx with the given nonce, or the last
+// observed signer set tx if the nonce is zero
+func runCheckpoint(cmd *cobra.Command, clientCtx client.Context, otx types.OutgoingTx, confs []types.EthereumTxConfirmation, signerSetNonce uint64) error {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	gravityID, _ := cmd.Flags().GetString(flagGravityID)
+	if gravityID == "" {
+		params, err := queryClient.Params(cmd.Context(), &types.ParamsRequest{})
+		if err != nil {
+			return err
+		}
+		gravityID = params.Params.GravityId
+	}
+
+	if nonce, _ := cmd.Flags().GetUint64(flagSignerSetNonce); nonce != 0 {
+		signerSetNonce = nonce
+	}
+	signerSet := &types.SignerSetTxResponse{}
+	if err := readOrQuery(cmd, clientCtx.Codec, flagSignerSetFile, signerSet, func() (proto.Message, error) {
+		if signerSetNonce == 0 {
+			return queryClient.LastObservedSignerSetTx(cmd.Context(), &types.LastObservedSignerSetTxRequest{})
+		}
+		return queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: signerSetNonce})
+	}); err != nil {
+		return err
+	}
+	if signerSet.SignerSet == nil {
+		return fmt.Errorf("signer set tx not found")
+	}
+
+	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+	report := verifyCheckpoint(checkpoint, confs, signerSet.SignerSet)
+	report.print(cmd.OutOrStdout())
+	return nil
+}
+
+// checkpointReport is the result of verifying confirmations of a checkpoint
+type checkpointReport struct {
+	checkpoint     []byte
+	signerSetNonce uint64
+	signers        []signerReport
+	unknown        []signerReport
+	signedPower    uint64
+	totalPower     uint64
+}
+
+type signerReport struct {
+	address common.Address
+	power   uint64
+	status  string
+}
+
+// verifyCheckpoint validates the confirmation signatures over the checkpoint
+// and sums up the power of the valid signatures from the signer set
+func verifyCheckpoint(checkpoint []byte, confs []types.EthereumTxConfirmation, signerSet *types.SignerSetTx) checkpointReport {
+	report := checkpointReport{
+		checkpoint:     checkpoint,
+		signerSetNonce: signerSet.Nonce,
+	}
+
+	signatures := make(map[common.Address][]byte, len(confs))
+	for _, conf := range confs {
+		signatures[conf.GetSigner()] = conf.GetSignature()
+	}
+
+	for _, signer := range signerSet.Signers {
+		address := common.HexToAddress(signer.EthereumAddress)
+		sr := signerReport{address: address, power: signer.Power, status: "missing"}
+		report.totalPower += signer.Power
+
+		if signature, ok := signatures[address]; ok {
+			delete(signatures, address)
+			if err := types.ValidateEthereumSignature(checkpoint, signature, address); err != nil {
+				sr.status = fmt.Sprintf("invalid: %s", err)
+			} else {
+				sr.status = "valid"
+				report.signedPower += signer.Power
+			}
+		}
+		report.signers = append(report.signers, sr)
+	}
+
+	for address, signature := range signatures {
+		sr := signerReport{address: address, status: "valid, not in signer set"}
+		if err := types.ValidateEthereumSignature(checkpoint, signature, address); err != nil {
+			sr.status = fmt.Sprintf("invalid: %s, not in signer set", err)
+		}
+		report.unknown = append(report.unknown, sr)
+	}
+	sort.Slice(report.unknown, func(i, j int) bool {
+		return bytes.Compare(report.unknown[i].address.Bytes(), report.unknown[j].address.Bytes()) < 0
+	})
+
+	return report
+}
+
+func (r checkpointReport) thresholdMet() bool {
+	return r.signedPower > types.EthereumSignerPowerThreshold
+}
+
+func (r checkpointReport) print(w io.Writer) {
+	fmt.Fprintf(w, "checkpoint: %s\n", hexutil.Encode(r.checkpoint))
+	fmt.Fprintf(w, "signer set: %d\n", r.signerSetNonce)
+	for _, sr := range append(r.signers, r.unknown...) {
+		fmt.Fprintf(w, "  %s\t%d\t%s\n", sr.address.Hex(), sr.power, sr.status)
+	}
+
+	var percent float64
+	if r.totalPower > 0 {
+		percent = float64(r.signedPower) / float64(r.totalPower) * 100
+	}
+	fmt.Fprintf(w, "signed power: %d of %d (%.2f%%)\n", r.signedPower, r.totalPower, percent)
+	fmt.Fprintf(w, "threshold: %d, met: %t\n", types.EthereumSignerPowerThreshold, r.thresholdMet())
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestVerifyCheckpoint(t *testing.T) {
+	var (
+		keys      []*ecdsa.PrivateKey
+		signerSet = &types.SignerSetTx{Nonce: 3}
+		powers    = []uint64{math.MaxUint32 / 2, math.MaxUint32 / 4, math.MaxUint32 / 4}
+		batch     = &types.BatchTx{
+			BatchNonce:    7,
+			Timeout:       1000,
+			TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Transactions: []*types.SendToEthereum{{
+				Id:                1,
+				EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+				Erc20Token:        types.NewERC20Token(100, "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"),
+				Erc20Fee:          types.NewERC20Token(1, "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"),
+			}},
+		}
+	)
+	for _, power := range powers {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		signerSet.Signers = append(signerSet.Signers, &types.EthereumSigner{
+			Power:           power,
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+
+	checkpoint := batch.GetCheckpoint([]byte("testgravityid"))
+	confirm := func(key *ecdsa.PrivateKey, hash []byte) types.EthereumTxConfirmation {
+		signature, err := types.NewEthereumSignature(hash, key)
+		require.NoError(t, err)
+		return &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:      signature,
+		}
+	}
+
+	// half the power is not enough
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	report := verifyCheckpoint(checkpoint, []types.EthereumTxConfirmation{
+		confirm(keys[0], checkpoint),
+		confirm(keys[1], batch.GetCheckpoint([]byte("othergravityid"))),
+		confirm(outsider, checkpoint),
+	}, signerSet)
+	require.Equal(t, powers[0], report.signedPower)
+	require.False(t, report.thresholdMet())
+	require.Equal(t, "valid", report.signers[0].status)
+	require.Contains(t, report.signers[1].status, "invalid")
+	require.Equal(t, "missing", report.signers[2].status)
+	require.Len(t, report.unknown, 1)
+
+	// three quarters of the power is
+	report = verifyCheckpoint(checkpoint, []types.EthereumTxConfirmation{
+		confirm(keys[0], checkpoint),
+		confirm(keys[2], checkpoint),
+	}, signerSet)
+	require.Equal(t, powers[0]+powers[2], report.signedPower)
+	require.True(t, report.thresholdMet())
+
+	var buf bytes.Buffer
+	report.print(&buf)
+	require.Contains(t, buf.String(), "met: true")
+	require.Contains(t, buf.String(), sdk.NewUint(report.signedPower).String())
+}
@@ -5,18 +5,32 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"strings"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitycli "github.com/peggyjv/gravity-bridge/module/x/gravity/client/cli"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
 )
 
-const flagPassphrase = "passphrase"
+const (
+	flagMnemonic = "mnemonic"
+	flagHDPath   = "hd-path"
+	flagKeystore = "keystore"
+
+	// defaultEthereumHDPath is the BIP-44 derivation path of the first
+	// ethereum account
+	defaultEthereumHDPath = "m/44'/60'/0'/0/0"
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -24,21 +38,35 @@ func Commands(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "eth_keys",
 		Short: "Manage your application's ethereum keys",
-		Long: `Keyring management commands. Generated by the official Ethereum go library.
+		Long: `Ethereum keyring management commands. Keys are stored as encrypted JSON keystore
+files generated by the official Ethereum go library, one file per key name.
 
 The keyring supports the following backends:
+    file        Encrypts keys with a passphrase, the passphrase is prompted for or read from
+                the file given with --passphrase-file.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+
+Keys added by earlier versions, stored directly in the keyring directory, are listed
+under their UTC--... key file name and can be used with either backend. Under the
+test backend they are decrypted with their former default passphrase.
 `,
 	}
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ImportKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		ExportKeyCommand(),
+		DeleteKeyCommand(),
+		SignHashCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, keyring.BackendFile, "Select keyring's backend (file|test)")
+	cmd.PersistentFlags().String(gravitycli.FlagPassphraseFile, "", "Read the keyring passphrase from a file instead of prompting for it")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
@@ -47,22 +75,212 @@ The keyring supports the following backends:
 // AddKeyCommand defines a keys command to generate a key
 func AddKeyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add",
+		Use:   "add [name]",
 		Short: "Add an encrypted private ethereum key",
 		Long: `Derive a new private key and encrypt to disk.
+
+Without a name the key is named eth- followed by its lowercase hex address.
 `,
+		Args: cobra.MaximumNArgs(1),
 		RunE: runAddCmd,
 	}
 
-	cmd.Flags().String(flagPassphrase, "default", "Password used for ethereum key generation")
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 
-	cmd.SetOut(cmd.OutOrStdout())
-	cmd.SetErr(cmd.ErrOrStderr())
+	return cmd
+}
+
+// ImportKeyCommand defines a keys command to import an existing key
+func ImportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [name]",
+		Short: "Import a private ethereum key",
+		Long: `Import a private ethereum key and encrypt it to disk.
+
+The key is read from one of:
+    a hex encoded private key, prompted for or read from stdin
+    a bip39 mnemonic prompted for with --mnemonic, derived at --hd-path
+    an ethereum JSON keystore file given with --keystore
+
+The private key is never given as argument, so it doesn't end up in the shell
+history or the process list.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImportCmd,
+	}
+
+	cmd.Flags().Bool(flagMnemonic, false, "Derive the key from a bip39 mnemonic")
+	cmd.Flags().String(flagHDPath, defaultEthereumHDPath, "BIP-44 derivation path used with --mnemonic")
+	cmd.Flags().String(flagKeystore, "", "Import the key from an ethereum JSON keystore file")
+
+	return cmd
+}
+
+// ListKeysCommand defines a keys command to list all keys
+func ListKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all ethereum keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			infos, err := kr.List()
+			if err != nil {
+				return err
+			}
+			return printKeyInfos(cmd, infos)
+		},
+	}
+}
+
+// ShowKeyCommand defines a keys command to show a key
+func ShowKeyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [name-or-address]",
+		Short: "Show an ethereum key by name or address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			info, err := kr.Get(args[0])
+			if err != nil {
+				return err
+			}
+			return printKeyInfos(cmd, []gravitycli.EthereumKeyInfo{info})
+		},
+	}
+}
+
+// ExportKeyCommand defines a keys command to export a private key
+func ExportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [name-or-address]",
+		Short: "Export an ethereum private key as unencrypted hex",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			if skip, _ := cmd.Flags().GetBool(flags.FlagSkipConfirmation); !skip {
+				ok, err := input.GetConfirmation("Exporting the private key in plain text is unsafe. Continue?", kr.Reader(), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return errors.New("aborted")
+				}
+			}
+
+			passphrase, err := kr.Passphrase("Enter the keyring passphrase:", false)
+			if err != nil {
+				return err
+			}
+			privateKey, err := kr.PrivateKey(args[0], passphrase)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), hexutil.Encode(crypto.FromECDSA(privateKey)))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP(flags.FlagSkipConfirmation, "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// DeleteKeyCommand defines a keys command to delete a key
+func DeleteKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [name-or-address]",
+		Short: "Delete an ethereum key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			info, err := kr.Get(args[0])
+			if err != nil {
+				return err
+			}
+
+			if skip, _ := cmd.Flags().GetBool(flags.FlagSkipConfirmation); !skip {
+				prompt := fmt.Sprintf("Key %s (%s) will be deleted. Continue?", info.Name, info.Address)
+				ok, err := input.GetConfirmation(prompt, kr.Reader(), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return errors.New("aborted")
+				}
+			}
+
+			if err := kr.Delete(info.Name); err != nil {
+				return err
+			}
+			cmd.PrintErrln("Key deleted forever (uh oh!)")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP(flags.FlagSkipConfirmation, "y", false, "Skip the confirmation prompt")
 
 	return cmd
 }
 
+// SignHashCommand defines a keys command to sign a hash the way the gravity
+// module expects ethereum signatures, e.g. on checkpoints or delegate keys
+func SignHashCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-hash [name-or-address] [hex-hash]",
+		Short: "Sign a 32 byte hash with an ethereum key",
+		Long: `Sign a 32 byte hash with an ethereum key. The hash is prefixed with the ethereum
+signed message prefix before signing, which is the format the gravity module
+verifies ethereum signatures in.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hash, err := hexutil.Decode(ensureHexPrefix(args[1]))
+			if err != nil {
+				return err
+			}
+			if len(hash) != 32 {
+				return fmt.Errorf("hash must be 32 bytes, got %d", len(hash))
+			}
+
+			kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, err := kr.Passphrase("Enter the keyring passphrase:", false)
+			if err != nil {
+				return err
+			}
+			privateKey, err := kr.PrivateKey(args[0], passphrase)
+			if err != nil {
+				return err
+			}
+
+			signature, err := types.NewEthereumSignature(hash, privateKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), hexutil.Encode(signature))
+			return nil
+		},
+	}
+}
+
 type EthereumKeyOutput struct {
 	PublicKey  string `json:"public_key"`
 	PrivateKey string `json:"private_key"`
@@ -74,37 +292,119 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	privateKeyBytes := crypto.FromECDSA(privateKey)
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return errors.New("error casting public key to ECDSA")
+	keyOutput, err := newEthereumKeyOutput(privateKey)
+	if err != nil {
+		return err
 	}
-	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
 
-	keyOutput := EthereumKeyOutput{
-		PrivateKey: hexutil.Encode(privateKeyBytes),
-		PublicKey:  hexutil.Encode(publicKeyBytes),
-		Address:    crypto.PubkeyToAddress(*publicKeyECDSA).Hex(),
+	name := "eth-" + strings.ToLower(strings.TrimPrefix(keyOutput.Address, "0x"))
+	if len(args) > 0 {
+		name = args[0]
 	}
 
 	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); !dryRun {
-		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err := storeEthereumKey(cmd, name, privateKey); err != nil {
+			return err
+		}
+	}
+
+	return printCreate(cmd, keyOutput)
+}
+
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	if keystoreFile, _ := cmd.Flags().GetString(flagKeystore); keystoreFile != "" {
+		keyJSON, err := ioutil.ReadFile(keystoreFile)
 		if err != nil {
 			return err
 		}
-		ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
-		passphrase, err := cmd.Flags().GetString(flagPassphrase)
+		keyJSONPassphrase, err := input.GetPassword("Enter the passphrase of the keystore file:", kr.Reader())
 		if err != nil {
 			return err
 		}
-		if _, err := ks.ImportECDSA(privateKey, passphrase); err != nil {
+		passphrase, err := kr.Passphrase("Enter a passphrase to encrypt the key:", true)
+		if err != nil {
 			return err
 		}
+		info, err := kr.ImportKeystore(args[0], keyJSON, keyJSONPassphrase, passphrase)
+		if err != nil {
+			return err
+		}
+		return printKeyInfos(cmd, []gravitycli.EthereumKeyInfo{info})
 	}
 
-	return printCreate(cmd, keyOutput)
+	var privateKey *ecdsa.PrivateKey
+	if useMnemonic, _ := cmd.Flags().GetBool(flagMnemonic); useMnemonic {
+		mnemonic, err := input.GetString("Enter your bip39 mnemonic", kr.Reader())
+		if err != nil {
+			return err
+		}
+		hdPath, _ := cmd.Flags().GetString(flagHDPath)
+		derived, err := hd.Secp256k1.Derive()(mnemonic, "", hdPath)
+		if err != nil {
+			return err
+		}
+		if privateKey, err = crypto.ToECDSA(derived); err != nil {
+			return err
+		}
+	} else {
+		hexKey, err := input.GetPassword("Enter the hex encoded private key:", kr.Reader())
+		if err != nil {
+			return err
+		}
+		if privateKey, err = crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x")); err != nil {
+			return err
+		}
+	}
+
+	passphrase, err := kr.Passphrase("Enter a passphrase to encrypt the key:", true)
+	if err != nil {
+		return err
+	}
+	info, err := kr.Import(args[0], privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+	return printKeyInfos(cmd, []gravitycli.EthereumKeyInfo{info})
+}
+
+func storeEthereumKey(cmd *cobra.Command, name string, privateKey *ecdsa.PrivateKey) error {
+	kr, err := gravitycli.NewEthereumKeyringFromCmd(cmd)
+	if err != nil {
+		return err
+	}
+	passphrase, err := kr.Passphrase("Enter a passphrase to encrypt the key:", true)
+	if err != nil {
+		return err
+	}
+	_, err = kr.Import(name, privateKey, passphrase)
+	return err
+}
+
+func newEthereumKeyOutput(privateKey *ecdsa.PrivateKey) (EthereumKeyOutput, error) {
+	publicKey := privateKey.Public()
+	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return EthereumKeyOutput{}, errors.New("error casting public key to ECDSA")
+	}
+
+	return EthereumKeyOutput{
+		PrivateKey: hexutil.Encode(crypto.FromECDSA(privateKey)),
+		PublicKey:  hexutil.Encode(crypto.FromECDSAPub(publicKeyECDSA)),
+		Address:    crypto.PubkeyToAddress(*publicKeyECDSA).Hex(),
+	}, nil
+}
+
+func ensureHexPrefix(s string) string {
+	if strings.HasPrefix(s, "0x") {
+		return s
+	}
+	return "0x" + s
 }
 
 func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
@@ -113,14 +413,40 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 	switch output {
 	case keys.OutputFormatText:
 		cmd.PrintErrln()
-		cmd.Printf("private: %s \npublic: %s \naddress: %s\n", keyOutput.PrivateKey, keyOutput.PublicKey, keyOutput.Address)
+		fmt.Fprintf(cmd.OutOrStdout(), "private: %s \npublic: %s \naddress: %s\n", keyOutput.PrivateKey, keyOutput.PublicKey, keyOutput.Address)
 
 	case keys.OutputFormatJSON:
 		outputBytes, err := json.Marshal(keyOutput)
 		if err != nil {
 			return err
 		}
-		cmd.Println(string(outputBytes))
+		fmt.Fprintln(cmd.OutOrStdout(), string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
+
+func printKeyInfos(cmd *cobra.Command, infos []gravitycli.EthereumKeyInfo) error {
+	output, _ := cmd.Flags().GetString(cli.OutputFlag)
+
+	switch output {
+	case keys.OutputFormatText:
+		for _, info := range infos {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\n", info.Name, info.Address)
+		}
+
+	case keys.OutputFormatJSON:
+		if infos == nil {
+			infos = []gravitycli.EthereumKeyInfo{}
+		}
+		outputBytes, err := json.Marshal(infos)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(outputBytes))
 
 	default:
 		return fmt.Errorf("invalid output format %s", output)
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	gravitycli "github.com/peggyjv/gravity-bridge/module/x/gravity/client/cli"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func runEthKeysCmd(t *testing.T, home string, in string, args ...string) (string, error) {
+	t.Helper()
+
+	rootCmd := &cobra.Command{Use: "gravity"}
+	rootCmd.AddCommand(Commands(home))
+	rootCmd.SetArgs(append([]string{"eth_keys"}, append(args, "--keyring-backend=test", "--keyring-dir="+home)...))
+	rootCmd.SetIn(strings.NewReader(in))
+
+	buf := bytes.NewBuffer(nil)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(bytes.NewBuffer(nil))
+
+	err := Execute(rootCmd)
+	return strings.TrimSpace(buf.String()), err
+}
+
+func TestEthKeys(t *testing.T) {
+	home := t.TempDir()
+	mnemonic := "test test test test test test test test test test test junk"
+
+	// import a key from a mnemonic with the default ethereum hd path
+	out, err := runEthKeysCmd(t, home, mnemonic+"\n", "import", "orch", "--mnemonic", "--output=json")
+	require.NoError(t, err)
+	var infos []gravitycli.EthereumKeyInfo
+	require.NoError(t, json.Unmarshal([]byte(out), &infos))
+	require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", infos[0].Address)
+
+	// the same key can't be imported twice
+	_, err = runEthKeysCmd(t, home, "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80\n", "import", "other")
+	require.Error(t, err)
+
+	// a hex private key is read from stdin, never from the arguments
+	_, err = runEthKeysCmd(t, home, "", "import", "other", "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+	require.Error(t, err)
+	out, err = runEthKeysCmd(t, home, "0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d\n", "import", "other", "--output=json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &infos))
+	require.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", infos[0].Address)
+	_, err = runEthKeysCmd(t, home, "y\n", "delete", "other")
+	require.NoError(t, err)
+
+	// without a name a key is named after its address
+	out, err = runEthKeysCmd(t, home, "", "add", "--output=json")
+	require.NoError(t, err)
+	var unnamed EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &unnamed))
+	out, err = runEthKeysCmd(t, home, "", "show", unnamed.Address)
+	require.NoError(t, err)
+	require.Equal(t, "eth-"+strings.ToLower(unnamed.Address[2:])+"\t"+unnamed.Address, out)
+	_, err = runEthKeysCmd(t, home, "y\n", "delete", unnamed.Address)
+	require.NoError(t, err)
+
+	// add and list keys
+	out, err = runEthKeysCmd(t, home, "", "add", "relayer", "--output=json")
+	require.NoError(t, err)
+	var added EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &added))
+
+	out, err = runEthKeysCmd(t, home, "", "list", "--output=json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &infos))
+	require.Equal(t, []gravitycli.EthereumKeyInfo{
+		{Name: "orch", Address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{Name: "relayer", Address: added.Address},
+	}, infos)
+
+	// show by address and export
+	out, err = runEthKeysCmd(t, home, "", "show", strings.ToLower(added.Address))
+	require.NoError(t, err)
+	require.Equal(t, "relayer\t"+added.Address, out)
+
+	out, err = runEthKeysCmd(t, home, "", "export", "relayer", "-y")
+	require.NoError(t, err)
+	require.Equal(t, added.PrivateKey, out)
+
+	// sign a hash the way the gravity module verifies it
+	hash := crypto.Keccak256([]byte("checkpoint"))
+	out, err = runEthKeysCmd(t, home, "", "sign-hash", "orch", hexutil.Encode(hash))
+	require.NoError(t, err)
+	signature, err := hexutil.Decode(out)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(hash, signature, common.HexToAddress(infos[0].Address)))
+
+	// delete asks for confirmation
+	_, err = runEthKeysCmd(t, home, "n\n", "delete", "relayer")
+	require.Error(t, err)
+	_, err = runEthKeysCmd(t, home, "y\n", "delete", "relayer")
+	require.NoError(t, err)
+	_, err = runEthKeysCmd(t, home, "", "show", "relayer")
+	require.Error(t, err)
+}
+
+func TestEthKeysLegacyKeys(t *testing.T) {
+	home := t.TempDir()
+
+	// a key added by earlier versions directly in the keyring directory
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	account, err := keystore.NewKeyStore(home, keystore.LightScryptN, keystore.LightScryptP).ImportECDSA(privateKey, "default")
+	require.NoError(t, err)
+
+	out, err := runEthKeysCmd(t, home, "", "show", account.Address.Hex())
+	require.NoError(t, err)
+	require.Equal(t, filepath.Base(account.URL.Path)+"\t"+account.Address.Hex(), out)
+
+	out, err = runEthKeysCmd(t, home, "", "export", account.Address.Hex(), "-y")
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(crypto.FromECDSA(privateKey)), out)
+
+	// ethereum keys can't be stored by the operating system
+	rootCmd := &cobra.Command{Use: "gravity"}
+	rootCmd.AddCommand(Commands(home))
+	rootCmd.SetArgs([]string{"eth_keys", "list", "--keyring-backend=os", "--keyring-dir=" + home})
+	rootCmd.SetOut(bytes.NewBuffer(nil))
+	rootCmd.SetErr(bytes.NewBuffer(nil))
+	require.Error(t, Execute(rootCmd))
+}
+
+func TestSignDelegateKeysWithEthKey(t *testing.T) {
+	home := t.TempDir()
+	mnemonic := "test test test test test test test test test test test junk"
+	ethAddr := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	valAddr := sdk.ValAddress(bytes.Repeat([]byte{1}, 20))
+
+	_, err := runEthKeysCmd(t, home, mnemonic+"\n", "import", "orch", "--mnemonic")
+	require.NoError(t, err)
+
+	sign := func(ethAddr common.Address) (signature []byte, err error) {
+		signCmd := &cobra.Command{
+			Use: "sign",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				signature, err = gravitycli.SignDelegateKeysFromCmd(cmd, "orch", ethAddr, valAddr, 3)
+				return nil
+			},
+		}
+		signCmd.Flags().String(flags.FlagKeyringDir, home, "")
+		signCmd.Flags().String(flags.FlagKeyringBackend, keyring.BackendTest, "")
+		signCmd.SetArgs([]string{})
+		require.NoError(t, signCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})))
+		return signature, err
+	}
+
+	// the key must match the delegated ethereum address
+	_, err = sign(common.Address{})
+	require.Error(t, err)
+
+	signature, err := sign(ethAddr)
+	require.NoError(t, err)
+
+	signMsgBz, err := (&types.DelegateKeysSignMsg{ValidatorAddress: valAddr.String(), Nonce: 3}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(crypto.Keccak256(signMsgBz), signature, ethAddr))
+}
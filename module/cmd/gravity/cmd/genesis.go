@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	flagBridgeEthereumAddress                     = "bridge-ethereum-address"
+	flagBridgeChainID                             = "bridge-chain-id"
+	flagContractSourceHash                        = "contract-source-hash"
+	flagContractArtifact                          = "contract-artifact"
+	flagSignedSignerSetTxsWindow                  = "signed-signer-set-txs-window"
+	flagSignedBatchesWindow                       = "signed-batches-window"
+	flagEthereumSignaturesWindow                  = "ethereum-signatures-window"
+	flagUnbondSlashingSignerSetTxsWindow          = "unbond-slashing-signer-set-txs-window"
+	flagTargetEthTxTimeout                        = "target-eth-tx-timeout"
+	flagAverageBlockTime                          = "average-block-time"
+	flagAverageEthereumBlockTime                  = "average-ethereum-block-time"
+	flagSlashFractionUnbondingSignerSetTx         = "slash-fraction-unbonding-signer-set-tx"
+	flagSlashFractionEthereumSignature            = "slash-fraction-ethereum-signature"
+	flagSlashFractionConflictingEthereumSignature = "slash-fraction-conflicting-ethereum-signature"
+)
+
+// GenesisCmd returns the genesis cobra Command for editing module genesis state
+func GenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Edit the module state in genesis.json",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(GravityParamsCmd(defaultNodeHome))
+
+	return cmd
+}
+
+// GravityParamsCmd returns the gravity-params cobra Command.
+func GravityParamsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gravity-params",
+		Short: "Set the gravity module params in genesis.json",
+		Long: `Set the gravity module params in genesis.json. Only the params given as flags
+are changed, the resulting params are validated before genesis.json is written.
+
+The contract source hash can be computed from the hardhat artifact of the deployed
+Gravity.sol contract with --contract-artifact, it is the keccak256 hash of the
+deployed bytecode and can be compared with the code of the bridge contract on
+ethereum. Warnings are printed for combinations of params that are valid but
+unsafe, such as slashing windows which are shorter than the unbonding period.
+`,
+		Example: fmt.Sprintf(`$ %s genesis gravity-params --gravity-id=gravity-mainnet \
+	--bridge-ethereum-address=0x69592e6f9d21989a043646fE8225da2600e5A0f7 --bridge-chain-id=1 \
+	--contract-artifact=solidity/artifacts/contracts/Gravity.sol/Gravity.json`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var gravityGenState types.GenesisState
+			if err := cdc.UnmarshalJSON(appState[types.ModuleName], &gravityGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal gravity genesis state: %w", err)
+			}
+			if gravityGenState.Params == nil {
+				gravityGenState.Params = types.DefaultParams()
+			}
+
+			if err := setGravityParamsFromFlags(cmd.Flags(), gravityGenState.Params); err != nil {
+				return err
+			}
+			if err := gravityGenState.Params.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid gravity params: %w", err)
+			}
+
+			stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+			for _, warning := range gravityParamsWarnings(*gravityGenState.Params, stakingGenState.Params.UnbondingTime) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: %s\n", warning)
+			}
+
+			gravityGenStateBz, err := cdc.MarshalJSON(&gravityGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal gravity genesis state: %w", err)
+			}
+			appState[types.ModuleName] = gravityGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			return clientCtx.WithOutput(cmd.OutOrStdout()).PrintProto(gravityGenState.Params)
+		},
+	}
+
+	defaults := types.DefaultParams()
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagGravityID, "", "Unique identifier of the bridge, signed over by orchestrators to prevent replays across bridges")
+	cmd.Flags().String(flagBridgeEthereumAddress, "", "Address of the Gravity.sol contract on ethereum")
+	cmd.Flags().Uint64(flagBridgeChainID, 0, "Chain id of the ethereum network the bridge contract is deployed to")
+	cmd.Flags().String(flagContractSourceHash, "", "Hash of the Gravity.sol contract")
+	cmd.Flags().String(flagContractArtifact, "", "Compute the contract source hash from the hardhat artifact of Gravity.sol")
+	cmd.Flags().Uint64(flagSignedSignerSetTxsWindow, defaults.SignedSignerSetTxsWindow, "Number of blocks validators have to sign a signer set tx before being slashed")
+	cmd.Flags().Uint64(flagSignedBatchesWindow, defaults.SignedBatchesWindow, "Number of blocks validators have to sign a batch tx before being slashed")
+	cmd.Flags().Uint64(flagEthereumSignaturesWindow, defaults.EthereumSignaturesWindow, "Number of blocks validators have to sign an outgoing tx before being slashed")
+	cmd.Flags().Uint64(flagUnbondSlashingSignerSetTxsWindow, defaults.UnbondSlashingSignerSetTxsWindow, "Number of blocks unbonding validators have to sign signer set txs before being slashed")
+	cmd.Flags().Uint64(flagTargetEthTxTimeout, defaults.TargetEthTxTimeout, "Target timeout of outgoing ethereum txs in milliseconds")
+	cmd.Flags().Uint64(flagAverageBlockTime, defaults.AverageBlockTime, "Average cosmos block time in milliseconds")
+	cmd.Flags().Uint64(flagAverageEthereumBlockTime, defaults.AverageEthereumBlockTime, "Average ethereum block time in milliseconds")
+	cmd.Flags().String(flagSlashFractionUnbondingSignerSetTx, defaults.SlashFractionUnbondingSignerSetTx.String(), "Fraction slashed for not signing a signer set tx while unbonding")
+	cmd.Flags().String(flagSlashFractionEthereumSignature, defaults.SlashFractionEthereumSignature.String(), "Fraction slashed for not signing an outgoing tx")
+	cmd.Flags().String(flagSlashFractionConflictingEthereumSignature, defaults.SlashFractionConflictingEthereumSignature.String(), "Fraction slashed for signing conflicting outgoing txs")
+
+	return cmd
+}
+
+// setGravityParamsFromFlags overwrites the params for every flag which was set
+func setGravityParamsFromFlags(fs *pflag.FlagSet, params *types.Params) (err error) {
+	if fs.Changed(flagContractArtifact) && fs.Changed(flagContractSourceHash) {
+		return fmt.Errorf("only one of --%s and --%s can be given", flagContractArtifact, flagContractSourceHash)
+	}
+
+	setString := func(name string, field *string) {
+		if err == nil && fs.Changed(name) {
+			*field, err = fs.GetString(name)
+		}
+	}
+	setUint64 := func(name string, field *uint64) {
+		if err == nil && fs.Changed(name) {
+			*field, err = fs.GetUint64(name)
+		}
+	}
+	setDec := func(name string, field *sdk.Dec) {
+		if err != nil || !fs.Changed(name) {
+			return
+		}
+		var s string
+		if s, err = fs.GetString(name); err != nil {
+			return
+		}
+		if *field, err = sdk.NewDecFromStr(s); err != nil {
+			err = fmt.Errorf("invalid --%s: %w", name, err)
+		} else if field.IsNegative() || field.GT(sdk.OneDec()) {
+			err = fmt.Errorf("invalid --%s: slash fraction must be between 0 and 1", name)
+		}
+	}
+
+	setString(flagGravityID, &params.GravityId)
+	setString(flagBridgeEthereumAddress, &params.BridgeEthereumAddress)
+	setUint64(flagBridgeChainID, &params.BridgeChainId)
+	setString(flagContractSourceHash, &params.ContractSourceHash)
+	setUint64(flagSignedSignerSetTxsWindow, &params.SignedSignerSetTxsWindow)
+	setUint64(flagSignedBatchesWindow, &params.SignedBatchesWindow)
+	setUint64(flagEthereumSignaturesWindow, &params.EthereumSignaturesWindow)
+	setUint64(flagUnbondSlashingSignerSetTxsWindow, &params.UnbondSlashingSignerSetTxsWindow)
+	setUint64(flagTargetEthTxTimeout, &params.TargetEthTxTimeout)
+	setUint64(flagAverageBlockTime, &params.AverageBlockTime)
+	setUint64(flagAverageEthereumBlockTime, &params.AverageEthereumBlockTime)
+	setDec(flagSlashFractionUnbondingSignerSetTx, &params.SlashFractionUnbondingSignerSetTx)
+	setDec(flagSlashFractionEthereumSignature, &params.SlashFractionEthereumSignature)
+	setDec(flagSlashFractionConflictingEthereumSignature, &params.SlashFractionConflictingEthereumSignature)
+	if err != nil {
+		return err
+	}
+
+	if fs.Changed(flagBridgeEthereumAddress) {
+		if !common.IsHexAddress(params.BridgeEthereumAddress) {
+			return fmt.Errorf("invalid --%s: not an ethereum address: %s", flagBridgeEthereumAddress, params.BridgeEthereumAddress)
+		}
+		params.BridgeEthereumAddress = common.HexToAddress(params.BridgeEthereumAddress).Hex()
+	}
+
+	if fs.Changed(flagContractArtifact) {
+		path, _ := fs.GetString(flagContractArtifact)
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if params.ContractSourceHash, err = contractSourceHash(bz); err != nil {
+			return fmt.Errorf("invalid --%s %s: %w", flagContractArtifact, path, err)
+		}
+	}
+
+	return nil
+}
+
+// contractSourceHash returns the keccak256 hash of the deployed bytecode in a
+// hardhat (or truffle) contract artifact
+func contractSourceHash(artifact []byte) (string, error) {
+	var contract struct {
+		ContractName     string `json:"contractName"`
+		DeployedBytecode string `json:"deployedBytecode"`
+	}
+	if err := json.Unmarshal(artifact, &contract); err != nil {
+		return "", err
+	}
+	if contract.ContractName != "" && contract.ContractName != "Gravity" {
+		return "", fmt.Errorf("artifact is for contract %s, not Gravity", contract.ContractName)
+	}
+
+	code, err := hexutil.Decode(contract.DeployedBytecode)
+	if err != nil {
+		return "", fmt.Errorf("deployed bytecode: %w", err)
+	}
+	if len(code) == 0 {
+		return "", fmt.Errorf("artifact has no deployed bytecode")
+	}
+
+	return crypto.Keccak256Hash(code).Hex(), nil
+}
+
+// gravityParamsWarnings returns the combinations of params which pass
+// validation but leave the bridge unsafe or unusable
+func gravityParamsWarnings(params types.Params, unbondingTime time.Duration) []string {
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+
+	if params.GravityId == types.DefaultParams().GravityId {
+		warn("gravity id is the default %q, signatures for this bridge can be replayed on other bridges using it", params.GravityId)
+	}
+	if params.BridgeEthereumAddress == (common.Address{}).Hex() {
+		warn("bridge ethereum address is not set")
+	}
+	if params.BridgeChainId == 0 {
+		warn("bridge chain id is not set")
+	}
+	if params.ContractSourceHash == "" {
+		warn("contract source hash is not set")
+	}
+
+	blockTime := time.Duration(params.AverageBlockTime) * time.Millisecond
+	windows := []struct {
+		name   string
+		blocks uint64
+	}{
+		{"signed signer set txs window", params.SignedSignerSetTxsWindow},
+		{"signed batches window", params.SignedBatchesWindow},
+		{"ethereum signatures window", params.EthereumSignaturesWindow},
+	}
+	for _, window := range windows {
+		if d := time.Duration(window.blocks) * blockTime; unbondingTime > 0 && d < unbondingTime {
+			warn("%s of %d blocks (~%s) is shorter than the unbonding period of %s, validators can stop signing without being slashed for it while still bonded",
+				window.name, window.blocks, d, unbondingTime)
+		}
+	}
+	if d := time.Duration(params.UnbondSlashingSignerSetTxsWindow) * blockTime; unbondingTime > 0 && d > unbondingTime {
+		warn("unbond slashing signer set txs window of %d blocks (~%s) is longer than the unbonding period of %s, unbonded validators can't be slashed for all of it",
+			params.UnbondSlashingSignerSetTxsWindow, d, unbondingTime)
+	}
+
+	if params.TargetEthTxTimeout < 10*params.AverageEthereumBlockTime {
+		warn("target eth tx timeout of %dms is less than 10 ethereum blocks, outgoing txs are likely to time out before they are relayed", params.TargetEthTxTimeout)
+	}
+
+	slashFractions := []struct {
+		name     string
+		fraction sdk.Dec
+	}{
+		{"unbonding signer set tx", params.SlashFractionUnbondingSignerSetTx},
+		{"ethereum signature", params.SlashFractionEthereumSignature},
+		{"conflicting ethereum signature", params.SlashFractionConflictingEthereumSignature},
+	}
+	for _, slashFraction := range slashFractions {
+		if slashFraction.fraction.IsNil() || slashFraction.fraction.IsZero() {
+			warn("slash fraction %s is zero, validators are not slashed for it", slashFraction.name)
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/peggyjv/gravity-bridge/module/app"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestGravityParamsCmd(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	cdc := app.MakeEncodingConfig().Marshaler
+	require.NoError(t, genutiltest.ExecInitCmd(app.ModuleBasics, home, cdc))
+
+	artifact := filepath.Join(home, "Gravity.json")
+	require.NoError(t, ioutil.WriteFile(artifact, []byte(`{"contractName":"Gravity","deployedBytecode":"0x6080604052"}`), 0o600))
+
+	run := func(args ...string) (string, error) {
+		serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+		clientCtx := client.Context{}.WithCodec(cdc).WithHomeDir(home)
+
+		ctx := context.Background()
+		ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+		ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+		cmd := GravityParamsCmd(home)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		stderr := bytes.NewBuffer(nil)
+		cmd.SetOut(bytes.NewBuffer(nil))
+		cmd.SetErr(stderr)
+		err := cmd.ExecuteContext(ctx)
+		return stderr.String(), err
+	}
+
+	// invalid values are rejected
+	_, err = run("--bridge-ethereum-address=0x1234")
+	require.Error(t, err)
+	_, err = run("--slash-fraction-unbonding-signer-set-tx=2")
+	require.Error(t, err)
+	_, err = run("--contract-artifact="+artifact, "--contract-source-hash=0x01")
+	require.Error(t, err)
+
+	stderr, err := run(
+		"--gravity-id=gravity-test",
+		"--bridge-ethereum-address=0x69592e6f9d21989a043646fe8225da2600e5a0f7",
+		"--bridge-chain-id=5",
+		"--contract-artifact="+artifact,
+		"--signed-batches-window=10",
+	)
+	require.NoError(t, err)
+	require.Contains(t, stderr, "WARNING: signed batches window of 10 blocks")
+	require.NotContains(t, stderr, "gravity id")
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	var genState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[types.ModuleName], &genState))
+	require.Equal(t, "gravity-test", genState.Params.GravityId)
+	require.Equal(t, "0x69592e6f9d21989a043646fE8225da2600e5A0f7", genState.Params.BridgeEthereumAddress)
+	require.Equal(t, uint64(5), genState.Params.BridgeChainId)
+	require.Equal(t, crypto.Keccak256Hash([]byte{0x60, 0x80, 0x60, 0x40, 0x52}).Hex(), genState.Params.ContractSourceHash)
+	require.Equal(t, uint64(10), genState.Params.SignedBatchesWindow)
+	require.Equal(t, types.DefaultParams().SignedSignerSetTxsWindow, genState.Params.SignedSignerSetTxsWindow)
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gravitycli "github.com/peggyjv/gravity-bridge/module/x/gravity/client/cli"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
 )
 
@@ -48,7 +49,7 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 	cmd := &cobra.Command{
 		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address] [eth-sig]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
-		Args:  cobra.ExactArgs(5),
+		Args:  cobra.RangeArgs(4, 5),
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
@@ -64,7 +65,11 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
     --details="..." \
     --security-contact="..." \
     --website="..."
-`, defaultsDesc, version.AppName,
+
+Instead of passing a pre-computed [eth-sig], --eth-key signs the delegate keys message with
+a key from the ethereum keyring (see eth_keys):
+$ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn --eth-key=my-eth-key
+`, defaultsDesc, version.AppName, version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -175,9 +180,25 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			ethSig, err := hexutil.Decode(args[4])
-			if err != nil {
-				return err
+			var ethSig []byte
+			if ethKey, _ := cmd.Flags().GetString(gravitycli.FlagEthKey); ethKey != "" {
+				if len(args) == 5 {
+					return fmt.Errorf("cannot pass both an eth-sig and --%s", gravitycli.FlagEthKey)
+				}
+
+				// genesis accounts start at sequence zero
+				ethSig, err = gravitycli.SignDelegateKeysFromCmd(cmd, ethKey, common.HexToAddress(ethAddress), sdk.ValAddress(key.GetAddress()), 0)
+				if err != nil {
+					return errors.Wrap(err, "failed to sign delegate keys")
+				}
+			} else {
+				if len(args) != 5 {
+					return fmt.Errorf("must pass an eth-sig or --%s", gravitycli.FlagEthKey)
+				}
+
+				if ethSig, err = hexutil.Decode(args[4]); err != nil {
+					return err
+				}
 			}
 
 			delegateGravityMsg := &gravitytypes.MsgDelegateKeys{
@@ -239,6 +260,9 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().String(gravitycli.FlagEthKey, "", "Sign the delegate keys message with this key name or address from the ethereum keyring")
+	cmd.Flags().String(gravitycli.FlagPassphraseFile, "", "Read the ethereum keyring passphrase from a file instead of prompting for it")
+	cmd.Flags().String(gravitycli.FlagEthKeyringBackend, "", "Select the ethereum keyring backend (file|test); if omitted, --keyring-backend is used")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -322,14 +346,14 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 
 			appMessage, err := GenAppStateFromConfig(cdc,
 				clientCtx.TxConfig,
-				config, initCfg, *genDoc, genBalIterator)
+				config, initCfg, *genDoc, genBalIterator, cmd.ErrOrStderr())
 			if err != nil {
 				return errors.Wrap(err, "failed to get genesis app state from config")
 			}
 
 			toPrint.AppMessage = appMessage
 
-			return displayInfo(toPrint)
+			return displayInfo(cmd.ErrOrStderr(), toPrint)
 		},
 	}
 
@@ -339,13 +363,13 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 	return cmd
 }
 
-func displayInfo(info printInfo) error {
+func displayInfo(w io.Writer, info printInfo) error {
 	out, err := json.MarshalIndent(info, "", " ")
 	if err != nil {
 		return err
 	}
 
-	_, err = fmt.Fprintf(os.Stderr, "%s\n", string(sdk.MustSortJSON(out)))
+	_, err = fmt.Fprintf(w, "%s\n", string(sdk.MustSortJSON(out)))
 
 	return err
 }
@@ -368,14 +392,16 @@ func newPrintInfo(moniker, chainID, nodeID, genTxsDir string, appMessage json.Ra
 	}
 }
 
-// GenAppStateFromConfig gets the genesis app state from the config
+// GenAppStateFromConfig gets the genesis app state from the config, the
+// report of the gravity delegate keys is written to out
 func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodingConfig,
 	config *cfg.Config, initCfg types.InitConfig, genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator,
+	out io.Writer,
 ) (appState json.RawMessage, err error) {
 
 	// process genesis transactions, else create default genesis.json
 	appGenTxs, persistentPeers, err := CollectTxs(
-		cdc, txEncodingConfig.TxJSONDecoder(), config.Moniker, initCfg.GenTxsDir, genDoc, genBalIterator,
+		cdc, txEncodingConfig.TxJSONDecoder(), config.Moniker, initCfg.GenTxsDir, genDoc, genBalIterator, out,
 	)
 	if err != nil {
 		return appState, err
@@ -413,8 +439,9 @@ func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodi
 
 // CollectTxs processes and validates application's genesis Txs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
+// The report of the gravity delegate keys is written to out.
 func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTxsDir string,
-	genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator,
+	genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator, out io.Writer,
 ) (appGenTxs []sdk.Tx, persistentPeers string, err error) {
 	// prepare a map of all balances in genesis state to then validate
 	// against the validators addresses
@@ -439,8 +466,17 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		},
 	)
 
+	// delegate keys already set in the gravity genesis state
+	var gravityGenState gravitytypes.GenesisState
+	if bz, ok := appState[gravitytypes.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, &gravityGenState); err != nil {
+			return appGenTxs, persistentPeers, err
+		}
+	}
+
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
+	var delegateKeys delegateKeysReport
 
 	for _, fo := range fos {
 		if fo.IsDir() {
@@ -515,7 +551,7 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 			)
 		}
 
-		// TODO: validate msgdelegatekeys
+		delegateKeys = append(delegateKeys, checkGenTxDelegateKeys(fo.Name(), msgs, balancesMap))
 
 		// exclude itself from persistent peers
 		if msg.Description.Moniker != moniker {
@@ -523,6 +559,12 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		}
 	}
 
+	delegateKeys.checkUnique(gravityGenState.DelegateKeys)
+	delegateKeys.print(out)
+	if n := delegateKeys.invalid(); n > 0 {
+		return appGenTxs, persistentPeers, fmt.Errorf("%d gentxs have invalid gravity delegate keys", n)
+	}
+
 	sort.Strings(addressesIPs)
 	persistentPeers = strings.Join(addressesIPs, ",")
 
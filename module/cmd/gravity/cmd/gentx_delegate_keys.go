@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// delegateKeysCheck is the result of validating the MsgDelegateKeys bundled
+// with the MsgCreateValidator of a single gentx
+type delegateKeysCheck struct {
+	file         string
+	moniker      string
+	validator    string
+	orchestrator string
+	ethereum     string
+	errs         []string
+	warnings     []string
+}
+
+func (c *delegateKeysCheck) fail(format string, args ...interface{}) {
+	c.errs = append(c.errs, fmt.Sprintf(format, args...))
+}
+
+func (c *delegateKeysCheck) warn(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
+}
+
+// delegateKeysReport collects the delegate keys checks of all gentxs
+type delegateKeysReport []*delegateKeysCheck
+
+// checkGenTxDelegateKeys validates the delegate keys in the messages of a
+// gentx the way the gravity msg server does when the gentx is delivered at
+// genesis. The signature is over the validator account sequence 0 and the
+// validator must exist, i.e. be created with a self-delegation earlier in the
+// same gentx.
+func checkGenTxDelegateKeys(file string, msgs []sdk.Msg, balances map[string]bankexported.GenesisBalance) *delegateKeysCheck {
+	check := &delegateKeysCheck{file: file}
+
+	var (
+		createValidator *stakingtypes.MsgCreateValidator
+		delegateKeys    *gravitytypes.MsgDelegateKeys
+	)
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			createValidator = msg
+		case *gravitytypes.MsgDelegateKeys:
+			if delegateKeys != nil {
+				check.fail("more than one MsgDelegateKeys")
+				return check
+			}
+			if createValidator == nil {
+				check.fail("MsgDelegateKeys before MsgCreateValidator, the validator doesn't exist yet")
+			}
+			delegateKeys = msg
+		}
+	}
+	if createValidator != nil {
+		check.moniker = createValidator.Description.Moniker
+		check.validator = createValidator.ValidatorAddress
+	}
+	if delegateKeys == nil {
+		check.fail("no MsgDelegateKeys")
+		return check
+	}
+
+	check.validator = delegateKeys.ValidatorAddress
+	check.orchestrator = delegateKeys.OrchestratorAddress
+	check.ethereum = delegateKeys.EthereumAddress
+	if err := delegateKeys.ValidateBasic(); err != nil {
+		check.fail("%s", err)
+		return check
+	}
+	valAddr, _ := sdk.ValAddressFromBech32(delegateKeys.ValidatorAddress)
+	ethAddr := common.HexToAddress(delegateKeys.EthereumAddress)
+	check.ethereum = ethAddr.Hex()
+
+	switch {
+	case createValidator == nil:
+		check.fail("no MsgCreateValidator")
+	case createValidator.ValidatorAddress != delegateKeys.ValidatorAddress:
+		check.fail("delegate keys are for validator %s, not %s", delegateKeys.ValidatorAddress, createValidator.ValidatorAddress)
+	case createValidator.DelegatorAddress != sdk.AccAddress(valAddr).String():
+		check.fail("no self-delegation, the validator is created by %s", createValidator.DelegatorAddress)
+	case !createValidator.Value.IsPositive():
+		check.fail("no self-delegation, the validator is created with %s", createValidator.Value)
+	}
+
+	signMsgBz, err := (&gravitytypes.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr.String(),
+		Nonce:            0,
+	}).Marshal()
+	if err != nil {
+		check.fail("%s", err)
+		return check
+	}
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+	if err := gravitytypes.ValidateEthereumSignature(hash, delegateKeys.EthSignature, ethAddr); err != nil {
+		check.fail("invalid ethereum signature over the delegate keys at sequence 0: %s", err)
+	}
+
+	if _, ok := balances[delegateKeys.OrchestratorAddress]; !ok {
+		check.warn("orchestrator %s has no genesis account and can't pay fees", delegateKeys.OrchestratorAddress)
+	}
+
+	return check
+}
+
+// checkUnique fails the checks which reuse an ethereum or orchestrator address
+// of another gentx or of the delegate keys already in the gravity genesis state
+func (r delegateKeysReport) checkUnique(genesisKeys []*gravitytypes.MsgDelegateKeys) {
+	ethereumAddrs := make(map[string]string)
+	orchestratorAddrs := make(map[string]string)
+	for _, keys := range genesisKeys {
+		ethereumAddrs[common.HexToAddress(keys.EthereumAddress).Hex()] = "gravity genesis state"
+		orchestratorAddrs[keys.OrchestratorAddress] = "gravity genesis state"
+	}
+
+	for _, check := range r {
+		if check.ethereum != "" {
+			if other, ok := ethereumAddrs[check.ethereum]; ok {
+				check.fail("ethereum address %s is also used in %s", check.ethereum, other)
+			} else {
+				ethereumAddrs[check.ethereum] = check.file
+			}
+		}
+		if check.orchestrator != "" {
+			if other, ok := orchestratorAddrs[check.orchestrator]; ok {
+				check.fail("orchestrator address %s is also used in %s", check.orchestrator, other)
+			} else {
+				orchestratorAddrs[check.orchestrator] = check.file
+			}
+		}
+	}
+}
+
+// invalid returns the number of gentxs with invalid delegate keys
+func (r delegateKeysReport) invalid() (n int) {
+	for _, check := range r {
+		if len(check.errs) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (r delegateKeysReport) print(w io.Writer) {
+	sort.SliceStable(r, func(i, j int) bool { return r[i].file < r[j].file })
+
+	fmt.Fprintln(w, "gravity delegate keys:")
+	for _, check := range r {
+		status := "ok"
+		if len(check.errs) > 0 {
+			status = "INVALID"
+		}
+		fmt.Fprintf(w, "  %s (%s): %s\n", check.file, check.moniker, status)
+		fmt.Fprintf(w, "    validator:    %s\n", check.validator)
+		fmt.Fprintf(w, "    orchestrator: %s\n", check.orchestrator)
+		fmt.Fprintf(w, "    ethereum:     %s\n", check.ethereum)
+		for _, err := range check.errs {
+			fmt.Fprintf(w, "    error:   %s\n", err)
+		}
+		for _, warning := range check.warnings {
+			fmt.Fprintf(w, "    warning: %s\n", warning)
+		}
+	}
+	fmt.Fprintf(w, "%d of %d gentxs have invalid delegate keys\n", r.invalid(), len(r))
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestCheckGenTxDelegateKeys(t *testing.T) {
+	balances := make(map[string]bankexported.GenesisBalance)
+
+	genTxMsgs := func(t *testing.T, ethKey *ecdsa.PrivateKey, orchAddr sdk.AccAddress, nonce uint64) []sdk.Msg {
+		valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		createValidator, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, secp256k1.GenPrivKey().PubKey(), sdk.NewInt64Coin("stake", 100),
+			stakingtypes.Description{Moniker: "val"}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		require.NoError(t, err)
+
+		signMsgBz, err := (&gravitytypes.DelegateKeysSignMsg{ValidatorAddress: valAddr.String(), Nonce: nonce}).Marshal()
+		require.NoError(t, err)
+		signature, err := gravitytypes.NewEthereumSignature(crypto.Keccak256(signMsgBz), ethKey)
+		require.NoError(t, err)
+
+		balances[sdk.AccAddress(valAddr).String()] = nil
+		return []sdk.Msg{createValidator, &gravitytypes.MsgDelegateKeys{
+			ValidatorAddress:    valAddr.String(),
+			OrchestratorAddress: orchAddr.String(),
+			EthereumAddress:     crypto.PubkeyToAddress(ethKey.PublicKey).Hex(),
+			EthSignature:        signature,
+		}}
+	}
+
+	ethKey1, _ := crypto.GenerateKey()
+	ethKey2, _ := crypto.GenerateKey()
+	orch1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	orch2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	valid := checkGenTxDelegateKeys("gentx-1.json", genTxMsgs(t, ethKey1, orch1, 0), balances)
+	require.Empty(t, valid.errs)
+	require.Len(t, valid.warnings, 1, "orchestrator without genesis account")
+
+	// signed at the wrong sequence
+	wrongNonce := checkGenTxDelegateKeys("gentx-2.json", genTxMsgs(t, ethKey2, orch2, 1), balances)
+	require.Len(t, wrongNonce.errs, 1)
+
+	// delegate keys for a validator created by someone else
+	msgs := genTxMsgs(t, ethKey2, orch2, 0)
+	msgs[0].(*stakingtypes.MsgCreateValidator).DelegatorAddress = orch2.String()
+	notSelf := checkGenTxDelegateKeys("gentx-3.json", msgs, balances)
+	require.Len(t, notSelf.errs, 1)
+
+	// delegate keys before the validator exists
+	msgs = genTxMsgs(t, ethKey2, orch2, 0)
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	require.Len(t, checkGenTxDelegateKeys("gentx-4.json", msgs, balances).errs, 1)
+
+	// reused ethereum and orchestrator addresses
+	balances[orch1.String()] = nil
+	duplicate := checkGenTxDelegateKeys("gentx-5.json", genTxMsgs(t, ethKey1, orch1, 0), balances)
+	require.Empty(t, duplicate.errs)
+	require.Empty(t, duplicate.warnings)
+	other := checkGenTxDelegateKeys("gentx-6.json", genTxMsgs(t, ethKey2, orch2, 0), balances)
+
+	report := delegateKeysReport{valid, wrongNonce, notSelf, duplicate, other}
+	report.checkUnique([]*gravitytypes.MsgDelegateKeys{{
+		OrchestratorAddress: orch2.String(),
+		EthereumAddress:     crypto.PubkeyToAddress(ethKey2.PublicKey).Hex(),
+	}})
+	require.Empty(t, valid.errs)
+	require.Len(t, duplicate.errs, 2)
+	require.Len(t, other.errs, 2)
+	require.Equal(t, 4, report.invalid())
+
+	var buf bytes.Buffer
+	report.print(&buf)
+	require.Contains(t, buf.String(), "gentx-1.json (val): ok")
+	require.Contains(t, buf.String(), "ethereum address "+valid.ethereum+" is also used in gentx-1.json")
+	require.Contains(t, buf.String(), "4 of 5 gentxs have invalid delegate keys")
+}
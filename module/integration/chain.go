@@ -0,0 +1,158 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/app"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	// txGas is the gas limit of every tx broadcast by the harness
+	txGas = 2000000
+	// txFees pays for txGas at the minimum gas price of the validators
+	txFees = "20stake"
+)
+
+// Chain is an in-process gravity network of several validators. Only the
+// first validator runs an RPC, all txs and queries go through it.
+type Chain struct {
+	Network *network.Network
+}
+
+// networkConfig returns a test network config running the gravity app with
+// the gravity params overridden by setParams
+func networkConfig(t *testing.T, numValidators int, setParams func(*types.Params)) network.Config {
+	encCfg := app.MakeEncodingConfig()
+
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.AccountRetriever = authtypes.AccountRetriever{}
+	cfg.NumValidators = numValidators
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.NewGravityApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(cfg.Codec)
+	var gravityGenesis types.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &gravityGenesis))
+	if setParams != nil {
+		setParams(gravityGenesis.Params)
+	}
+	bz, err := cfg.Codec.MarshalJSON(&gravityGenesis)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = bz
+
+	return cfg
+}
+
+// NewChain starts a network of numValidators validators and waits for its
+// first block
+func NewChain(t *testing.T, numValidators int, setParams func(*types.Params)) *Chain {
+	n := network.New(t, networkConfig(t, numValidators, setParams))
+	_, err := n.WaitForHeight(1)
+	require.NoError(t, err)
+	return &Chain{Network: n}
+}
+
+// Cleanup stops the network
+func (c *Chain) Cleanup() {
+	c.Network.Cleanup()
+}
+
+// Validators returns the validators of the network
+func (c *Chain) Validators() []*network.Validator {
+	return c.Network.Validators
+}
+
+// QueryClient returns a gravity query client
+func (c *Chain) QueryClient() types.QueryClient {
+	return types.NewQueryClient(c.Network.Validators[0].ClientCtx)
+}
+
+// Balance returns the balance of addr in denom
+func (c *Chain) Balance(ctx context.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	res, err := banktypes.NewQueryClient(c.Network.Validators[0].ClientCtx).Balance(ctx, banktypes.NewQueryBalanceRequest(addr, denom))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return *res.Balance, nil
+}
+
+// Sequence returns the account sequence of the validator account
+func (c *Chain) Sequence(val *network.Validator) (uint64, error) {
+	clientCtx := c.Network.Validators[0].ClientCtx
+	_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, val.Address)
+	return seq, err
+}
+
+// BroadcastTx signs the msgs with the key of the validator account and
+// broadcasts them in a single tx, waiting for it to be included in a block
+func (c *Chain) BroadcastTx(val *network.Validator, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	clientCtx := c.Network.Validators[0].ClientCtx.
+		WithKeyring(val.ClientCtx.Keyring).
+		WithFromName(val.Moniker).
+		WithFromAddress(val.Address).
+		WithBroadcastMode(flags.BroadcastBlock)
+
+	num, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, val.Address)
+	if err != nil {
+		return nil, err
+	}
+	txf := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithAccountNumber(num).
+		WithSequence(seq).
+		WithGas(txGas).
+		WithFees(txFees)
+
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, val.Moniker, txb, true); err != nil {
+		return nil, err
+	}
+	bz, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(bz)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
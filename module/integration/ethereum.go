@@ -0,0 +1,453 @@
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	// ArtifactsDirEnv overrides the directory of the hardhat artifacts of the
+	// solidity contracts
+	ArtifactsDirEnv = "GRAVITY_ARTIFACTS_DIR"
+	// RequireArtifactsEnv makes the tests which need ethereum fail instead of
+	// skipping when the hardhat artifacts are missing, so that CI can't pass
+	// without running them
+	RequireArtifactsEnv = "GRAVITY_REQUIRE_ARTIFACTS"
+
+	ethGasLimit = 8000000
+)
+
+// minerKey is the testnet key TestERC20A mints most of its supply to
+var minerKey = "b1bab011e03a9862664706fc3bbaa1b16651528e5f0e7fbfcbfdd8be302a13e7"
+
+//go:generate sh -c "cd ../../solidity && npm ci && npx hardhat compile"
+
+// defaultArtifactsDir is where `npx hardhat compile` in solidity/ writes the
+// artifacts, relative to this package
+var defaultArtifactsDir = filepath.Join("..", "..", "solidity", "artifacts", "contracts")
+
+// artifact is a contract compiled by hardhat
+type artifact struct {
+	ContractName string          `json:"contractName"`
+	ABI          json.RawMessage `json:"abi"`
+	Bytecode     string          `json:"bytecode"`
+}
+
+// artifactsDir returns the directory of the hardhat artifacts, or an error if
+// the contracts haven't been compiled
+func artifactsDir() (string, error) {
+	dir := os.Getenv(ArtifactsDirEnv)
+	if dir == "" {
+		dir = defaultArtifactsDir
+	}
+	if _, err := os.Stat(filepath.Join(dir, "Gravity.sol", "Gravity.json")); err != nil {
+		return "", fmt.Errorf("no compiled Gravity.sol in %s, run `go generate ./integration` or set %s: %w", dir, ArtifactsDirEnv, err)
+	}
+	return dir, nil
+}
+
+func loadArtifact(dir, contract string) (abi.ABI, []byte, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, contract+".sol", contract+".json"))
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	var a artifact
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("%s: %w", contract, err)
+	}
+	contractABI, err := abi.JSON(strings.NewReader(string(a.ABI)))
+	if err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("%s: %w", contract, err)
+	}
+	bytecode, err := hexutil.Decode(a.Bytecode)
+	if err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("%s: %w", contract, err)
+	}
+	return contractABI, bytecode, nil
+}
+
+// Ethereum is a simulated ethereum chain with a Gravity.sol deployment and a
+// TestERC20A token. Blocks are only mined on Commit.
+type Ethereum struct {
+	Backend *backends.SimulatedBackend
+	// MinerKey holds most of the TestERC20A supply
+	MinerKey *ecdsa.PrivateKey
+
+	GravityAddress common.Address
+	TokenAddress   common.Address
+
+	gravityABI abi.ABI
+	gravity    *bind.BoundContract
+	token      *bind.BoundContract
+}
+
+// NewEthereum starts a simulated chain where the miner and the given
+// accounts are funded with ether
+func NewEthereum(accounts ...common.Address) (*Ethereum, error) {
+	minerKey, err := crypto.HexToECDSA(minerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	alloc := core.GenesisAlloc{crypto.PubkeyToAddress(minerKey.PublicKey): {Balance: balance}}
+	for _, account := range accounts {
+		alloc[account] = core.GenesisAccount{Balance: balance}
+	}
+
+	return &Ethereum{
+		Backend:  backends.NewSimulatedBackend(alloc, ethGasLimit),
+		MinerKey: minerKey,
+		// the first contract the miner deploys
+		GravityAddress: crypto.CreateAddress(crypto.PubkeyToAddress(minerKey.PublicKey), 0),
+	}, nil
+}
+
+// ChainID returns the ethereum chain id of the simulated chain
+func (e *Ethereum) ChainID() *big.Int {
+	return e.Backend.Blockchain().Config().ChainID
+}
+
+// Height returns the latest block number
+func (e *Ethereum) Height() uint64 {
+	return e.Backend.Blockchain().CurrentBlock().NumberU64()
+}
+
+// Commit mines n blocks
+func (e *Ethereum) Commit(n int) {
+	for i := 0; i < n; i++ {
+		e.Backend.Commit()
+	}
+}
+
+// Transactor returns the transact options of the key
+func (e *Ethereum) Transactor(key *ecdsa.PrivateKey) (*bind.TransactOpts, error) {
+	opts, err := bind.NewKeyedTransactorWithChainID(key, e.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	opts.GasLimit = ethGasLimit
+	return opts, nil
+}
+
+// Deploy deploys Gravity.sol, at GravityAddress, for the signers and
+// TestERC20A. The signer set is checkpointed at nonce 0 like the contract
+// deployer does.
+func (e *Ethereum) Deploy(gravityID string, signers types.EthereumSigners) error {
+	dir, err := artifactsDir()
+	if err != nil {
+		return err
+	}
+	gravityABI, gravityBytecode, err := loadArtifact(dir, "Gravity")
+	if err != nil {
+		return err
+	}
+	tokenABI, tokenBytecode, err := loadArtifact(dir, "TestERC20A")
+	if err != nil {
+		return err
+	}
+
+	var id [32]byte
+	copy(id[:], gravityID)
+	addresses := make([]common.Address, len(signers))
+	powers := make([]*big.Int, len(signers))
+	for i, signer := range signers {
+		addresses[i] = common.HexToAddress(signer.EthereumAddress)
+		powers[i] = new(big.Int).SetUint64(signer.Power)
+	}
+
+	opts, err := e.Transactor(e.MinerKey)
+	if err != nil {
+		return err
+	}
+	gravityAddress, _, gravity, err := bind.DeployContract(opts, gravityABI, gravityBytecode, e.Backend, id, new(big.Int).SetUint64(types.EthereumSignerPowerThreshold), addresses, powers)
+	if err != nil {
+		return fmt.Errorf("deploy Gravity.sol: %w", err)
+	}
+	if gravityAddress != e.GravityAddress {
+		return fmt.Errorf("Gravity.sol deployed at %s instead of %s", gravityAddress.Hex(), e.GravityAddress.Hex())
+	}
+	e.Backend.Commit()
+
+	tokenAddress, _, token, err := bind.DeployContract(opts, tokenABI, tokenBytecode, e.Backend)
+	if err != nil {
+		return fmt.Errorf("deploy TestERC20A: %w", err)
+	}
+	e.Backend.Commit()
+
+	e.gravityABI, e.gravity, e.token, e.TokenAddress = gravityABI, gravity, token, tokenAddress
+	return nil
+}
+
+func (e *Ethereum) transact(contract *bind.BoundContract, key *ecdsa.PrivateKey, method string, params ...interface{}) error {
+	opts, err := e.Transactor(key)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.Transact(opts, method, params...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	e.Backend.Commit()
+
+	receipt, err := e.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("%s reverted", method)
+	}
+	return nil
+}
+
+func (e *Ethereum) call(contract *bind.BoundContract, method string, params ...interface{}) (interface{}, error) {
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{}, &out, method, params...); err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	return out[0], nil
+}
+
+// SendToCosmos approves and deposits amount of the token from the key's
+// account to the cosmos receiver
+func (e *Ethereum) SendToCosmos(key *ecdsa.PrivateKey, receiver sdk.AccAddress, amount int64) error {
+	if err := e.transact(e.token, key, "approve", e.GravityAddress, big.NewInt(amount)); err != nil {
+		return err
+	}
+	var destination [32]byte
+	copy(destination[12:], receiver)
+	return e.transact(e.gravity, key, "sendToCosmos", e.TokenAddress, destination, big.NewInt(amount))
+}
+
+// TokenBalance returns the token balance of addr
+func (e *Ethereum) TokenBalance(addr common.Address) (*big.Int, error) {
+	out, err := e.call(e.token, "balanceOf", addr)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*big.Int), nil
+}
+
+// LastValsetNonce returns the nonce of the signer set the contract is at
+func (e *Ethereum) LastValsetNonce() (uint64, error) {
+	out, err := e.call(e.gravity, "state_lastValsetNonce")
+	if err != nil {
+		return 0, err
+	}
+	return out.(*big.Int).Uint64(), nil
+}
+
+// LastBatchNonce returns the nonce of the last batch of the token executed
+func (e *Ethereum) LastBatchNonce(token common.Address) (uint64, error) {
+	out, err := e.call(e.gravity, "lastBatchNonce", token)
+	if err != nil {
+		return 0, err
+	}
+	return out.(*big.Int).Uint64(), nil
+}
+
+// contractSignerSet is the signer set checkpointed by the contract
+type contractSignerSet struct {
+	nonce   uint64
+	signers types.EthereumSigners
+}
+
+// Events returns the gravity events of the blocks from..to in event nonce
+// order, together with the signer set the contract is at
+func (e *Ethereum) Events(ctx context.Context, from, to uint64) ([]types.EthereumEvent, *contractSignerSet, error) {
+	logs, err := e.Backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{e.GravityAddress},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		events    []types.EthereumEvent
+		signerSet *contractSignerSet
+	)
+	for _, log := range logs {
+		event, set, err := e.decodeEvent(log)
+		if err != nil {
+			return nil, nil, err
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+		if set != nil {
+			signerSet = set
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].GetEventNonce() < events[j].GetEventNonce() })
+	return events, signerSet, nil
+}
+
+func (e *Ethereum) decodeEvent(log ethtypes.Log) (types.EthereumEvent, *contractSignerSet, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil, nil
+	}
+	ev, err := e.gravityABI.EventByID(log.Topics[0])
+	if err != nil {
+		// not a gravity event
+		return nil, nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if err := e.gravity.UnpackLogIntoMap(m, ev.Name, log); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", ev.Name, err)
+	}
+	eventNonce := func() uint64 { return m["_eventNonce"].(*big.Int).Uint64() }
+
+	switch ev.Name {
+	case "SendToCosmosEvent":
+		destination := m["_destination"].([32]byte)
+		return &types.SendToCosmosEvent{
+			EventNonce:     eventNonce(),
+			TokenContract:  m["_tokenContract"].(common.Address).Hex(),
+			Amount:         sdk.NewIntFromBigInt(m["_amount"].(*big.Int)),
+			EthereumSender: m["_sender"].(common.Address).Hex(),
+			CosmosReceiver: sdk.AccAddress(destination[12:]).String(),
+			EthereumHeight: log.BlockNumber,
+			ReceivedAmount: sdk.ZeroInt(),
+			EscrowBalance:  sdk.ZeroInt(),
+		}, nil, nil
+
+	case "TransactionBatchExecutedEvent":
+		return &types.BatchExecutedEvent{
+			TokenContract:  m["_token"].(common.Address).Hex(),
+			EventNonce:     eventNonce(),
+			EthereumHeight: log.BlockNumber,
+			BatchNonce:     m["_batchNonce"].(*big.Int).Uint64(),
+			EscrowBalance:  sdk.ZeroInt(),
+		}, nil, nil
+
+	case "ERC20DeployedEvent":
+		return &types.ERC20DeployedEvent{
+			EventNonce:     eventNonce(),
+			CosmosDenom:    m["_cosmosDenom"].(string),
+			TokenContract:  m["_tokenContract"].(common.Address).Hex(),
+			Erc20Name:      m["_name"].(string),
+			Erc20Symbol:    m["_symbol"].(string),
+			Erc20Decimals:  uint64(m["_decimals"].(uint8)),
+			EthereumHeight: log.BlockNumber,
+		}, nil, nil
+
+	case "ValsetUpdatedEvent":
+		set := &contractSignerSet{nonce: m["_newValsetNonce"].(*big.Int).Uint64()}
+		validators := m["_validators"].([]common.Address)
+		powers := m["_powers"].([]*big.Int)
+		for i := range validators {
+			set.signers = append(set.signers, &types.EthereumSigner{
+				EthereumAddress: validators[i].Hex(),
+				Power:           powers[i].Uint64(),
+			})
+		}
+		return &types.SignerSetTxExecutedEvent{
+			EventNonce:       eventNonce(),
+			SignerSetTxNonce: set.nonce,
+			EthereumHeight:   log.BlockNumber,
+			Members:          set.signers,
+		}, set, nil
+
+	case "LogicCallEvent":
+		scope := m["_invalidationId"].([32]byte)
+		return &types.ContractCallExecutedEvent{
+			EventNonce:        eventNonce(),
+			InvalidationScope: scope[:],
+			InvalidationNonce: m["_invalidationNonce"].(*big.Int).Uint64(),
+			EthereumHeight:    log.BlockNumber,
+		}, nil, nil
+	}
+	return nil, nil, nil
+}
+
+// confirmationsToSignatures orders the signatures of the confirmations by the
+// signers of the contract's current signer set, as the contract expects
+func confirmationsToSignatures(signers types.EthereumSigners, signatures map[string][]byte) (v []uint8, r, s [][32]byte) {
+	for _, signer := range signers {
+		var rr, ss [32]byte
+		var vv uint8
+		if sig, ok := signatures[common.HexToAddress(signer.EthereumAddress).Hex()]; ok && len(sig) == 65 {
+			copy(rr[:], sig[:32])
+			copy(ss[:], sig[32:64])
+			vv = sig[64]
+			if vv < 27 {
+				vv += 27
+			}
+		}
+		v, r, s = append(v, vv), append(r, rr), append(s, ss)
+	}
+	return v, r, s
+}
+
+// signerSetArgs returns the addresses and powers of a signer set
+func signerSetArgs(signers types.EthereumSigners) ([]common.Address, []*big.Int) {
+	addresses := make([]common.Address, len(signers))
+	powers := make([]*big.Int, len(signers))
+	for i, signer := range signers {
+		addresses[i] = common.HexToAddress(signer.EthereumAddress)
+		powers[i] = new(big.Int).SetUint64(signer.Power)
+	}
+	return addresses, powers
+}
+
+// UpdateValset relays the signer set tx to the contract, signed over by the
+// contract's current signer set
+func (e *Ethereum) UpdateValset(key *ecdsa.PrivateKey, current *contractSignerSet, next *types.SignerSetTx, signatures map[string][]byte) error {
+	newAddresses, newPowers := signerSetArgs(next.Signers)
+	addresses, powers := signerSetArgs(current.signers)
+	v, r, s := confirmationsToSignatures(current.signers, signatures)
+	return e.transact(e.gravity, key, "updateValset",
+		newAddresses, newPowers, new(big.Int).SetUint64(next.Nonce),
+		addresses, powers, new(big.Int).SetUint64(current.nonce),
+		v, r, s,
+	)
+}
+
+// SubmitBatch relays the batch to the contract, signed over by the
+// contract's current signer set
+func (e *Ethereum) SubmitBatch(key *ecdsa.PrivateKey, current *contractSignerSet, batch *types.BatchTx, signatures map[string][]byte) error {
+	addresses, powers := signerSetArgs(current.signers)
+	v, r, s := confirmationsToSignatures(current.signers, signatures)
+
+	amounts := make([]*big.Int, len(batch.Transactions))
+	destinations := make([]common.Address, len(batch.Transactions))
+	fees := make([]*big.Int, len(batch.Transactions))
+	for i, ste := range batch.Transactions {
+		amounts[i] = ste.Erc20Token.Amount.BigInt()
+		destinations[i] = common.HexToAddress(ste.EthereumRecipient)
+		fees[i] = ste.Erc20Fee.Amount.BigInt()
+	}
+
+	return e.transact(e.gravity, key, "submitBatch",
+		addresses, powers, new(big.Int).SetUint64(current.nonce),
+		v, r, s,
+		amounts, destinations, fees,
+		new(big.Int).SetUint64(batch.BatchNonce),
+		common.HexToAddress(batch.TokenContract),
+		new(big.Int).SetUint64(batch.Timeout),
+	)
+}
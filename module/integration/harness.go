@@ -0,0 +1,156 @@
+// Package integration runs the gravity bridge end to end under plain
+// `go test`: an in-process network of gravity validators, a simulated
+// ethereum chain running Gravity.sol and a minimal Go orchestrator and relayer
+// moving events and signatures between them.
+//
+// The ethereum side needs the hardhat artifacts of the contracts, built with
+// `go generate ./integration` (which runs `npx hardhat compile` in solidity/)
+// or pointed to by GRAVITY_ARTIFACTS_DIR. Without them only the scenarios
+// which don't touch ethereum run, unless GRAVITY_REQUIRE_ARTIFACTS is set.
+// `make test-integration` builds the artifacts and requires them.
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const gravityID = "gravitytest"
+
+// Harness is a gravity network whose validators run an orchestrator, bridged
+// to a simulated ethereum chain if the contract artifacts are available
+type Harness struct {
+	Chain         *Chain
+	Orchestrators []*Orchestrator
+	// Ethereum is nil if the harness runs without ethereum
+	Ethereum *Ethereum
+	Relayer  *Relayer
+	// RelayerPaused stops the relayer from submitting to the contract, so
+	// outgoing txs time out
+	RelayerPaused bool
+
+	t *testing.T
+}
+
+// New starts a network of numValidators validators, registers the delegate
+// keys of their orchestrators and waits for a signer set of all of them. If
+// withEthereum is true, Gravity.sol is deployed for the signer set and the
+// test is skipped if the contract artifacts are not available.
+func New(t *testing.T, numValidators int, withEthereum bool, setParams func(*types.Params)) *Harness {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	if withEthereum {
+		if _, err := artifactsDir(); err != nil {
+			if os.Getenv(RequireArtifactsEnv) != "" {
+				t.Fatal(err)
+			}
+			t.Skip(err)
+		}
+	}
+
+	h := &Harness{t: t}
+
+	var eth *Ethereum
+	if withEthereum {
+		var err error
+		eth, err = NewEthereum()
+		require.NoError(t, err)
+	}
+
+	h.Chain = NewChain(t, numValidators, func(p *types.Params) {
+		p.GravityId = gravityID
+		if eth != nil {
+			p.BridgeEthereumAddress = eth.GravityAddress.Hex()
+			p.BridgeChainId = eth.ChainID().Uint64()
+		}
+		if setParams != nil {
+			setParams(p)
+		}
+	})
+	t.Cleanup(h.Chain.Cleanup)
+
+	for _, val := range h.Chain.Validators() {
+		orch, err := NewOrchestrator(h.Chain, val, gravityID)
+		require.NoError(t, err)
+		h.Orchestrators = append(h.Orchestrators, orch)
+	}
+
+	h.WaitFor("a signer set of all validators", func(ctx context.Context) (bool, error) {
+		res, err := h.Chain.QueryClient().LatestSignerSetTx(ctx, &types.LatestSignerSetTxRequest{})
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		return res.SignerSet != nil && len(res.SignerSet.Signers) == numValidators, nil
+	})
+
+	if eth != nil {
+		res, err := h.Chain.QueryClient().LatestSignerSetTx(context.Background(), &types.LatestSignerSetTxRequest{})
+		require.NoError(t, err)
+		require.NoError(t, eth.Deploy(gravityID, res.SignerSet.Signers))
+		h.Ethereum = eth
+		h.Relayer = &Relayer{Key: eth.MinerKey, chain: h.Chain, eth: eth}
+	}
+
+	return h
+}
+
+// Tick runs every orchestrator and the relayer once, mines an ethereum block
+// and waits for the next block of the chain
+func (h *Harness) Tick(ctx context.Context) error {
+	for _, orch := range h.Orchestrators {
+		if err := orch.Tick(ctx, h.Ethereum); err != nil {
+			return fmt.Errorf("orchestrator of %s: %w", orch.Validator.Moniker, err)
+		}
+	}
+	if h.Ethereum != nil {
+		if h.Relayer != nil && !h.RelayerPaused {
+			if err := h.Relayer.Relay(ctx); err != nil {
+				return fmt.Errorf("relayer: %w", err)
+			}
+		}
+		h.Ethereum.Commit(1)
+	}
+	return h.Chain.Network.WaitForNextBlock()
+}
+
+// WaitFor ticks until the condition is met, failing the test after a minute
+func (h *Harness) WaitFor(description string, cond func(ctx context.Context) (bool, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for {
+		ok, err := cond(ctx)
+		require.NoError(h.t, err, description)
+		if ok {
+			return
+		}
+		require.NoError(h.t, ctx.Err(), "waiting for %s", description)
+		require.NoError(h.t, h.Tick(ctx), "waiting for %s", description)
+	}
+}
+
+// Denom returns the denom of the vouchers of the test token
+func (h *Harness) Denom() string {
+	return types.NewERC20Token(0, h.Ethereum.TokenAddress.Hex()).GravityCoin().Denom
+}
+
+// SignerSetConfirmed returns true if every validator signed the signer set tx
+func (h *Harness) SignerSetConfirmed(ctx context.Context, nonce uint64) (bool, error) {
+	res, err := h.Chain.QueryClient().SignerSetTxConfirmations(ctx, &types.SignerSetTxConfirmationsRequest{SignerSetNonce: nonce})
+	if err != nil {
+		return false, err
+	}
+	return len(res.Signatures) == len(h.Orchestrators), nil
+}
@@ -0,0 +1,194 @@
+package integration
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/app"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func init() {
+	app.SetAddressConfig()
+}
+
+// bridgeParams make the timeout of outgoing txs 120 ethereum blocks after the
+// last observed ethereum height, projecting only a tenth of an ethereum block
+// per cosmos block
+func bridgeParams(p *types.Params) {
+	p.TargetEthTxTimeout = 120000
+	p.AverageEthereumBlockTime = 1000
+	p.AverageBlockTime = 100
+}
+
+func latestSignerSetTx(t *testing.T, h *Harness) *types.SignerSetTx {
+	res, err := h.Chain.QueryClient().LatestSignerSetTx(context.Background(), &types.LatestSignerSetTxRequest{})
+	require.NoError(t, err)
+	return res.SignerSet
+}
+
+// delegate bonds more stake to the first validator, which changes the powers
+// enough for a new signer set tx
+func delegate(t *testing.T, h *Harness) {
+	val := h.Chain.Validators()[0]
+	msg := stakingtypes.NewMsgDelegate(val.Address, val.ValAddress, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)))
+	_, err := h.Chain.BroadcastTx(val, msg)
+	require.NoError(t, err)
+}
+
+func TestSignerSetConfirmations(t *testing.T) {
+	h := New(t, 3, false, nil)
+
+	first := latestSignerSetTx(t, h)
+	h.WaitFor("the signer set to be confirmed", func(ctx context.Context) (bool, error) {
+		return h.SignerSetConfirmed(ctx, first.Nonce)
+	})
+
+	delegate(t, h)
+	h.WaitFor("a new signer set to be confirmed", func(ctx context.Context) (bool, error) {
+		latest := latestSignerSetTx(t, h)
+		if latest.Nonce == first.Nonce {
+			return false, nil
+		}
+		return h.SignerSetConfirmed(ctx, latest.Nonce)
+	})
+
+	latest := latestSignerSetTx(t, h)
+	require.Len(t, latest.Signers, 3)
+	require.NotEqual(t, first.Signers, latest.Signers)
+}
+
+func TestBridge(t *testing.T) {
+	h := New(t, 3, true, bridgeParams)
+	eth := h.Ethereum
+	val := h.Chain.Validators()[0]
+	queryClient := h.Chain.QueryClient()
+
+	deposit := func(t *testing.T, amount int64) {
+		before, err := h.Chain.Balance(context.Background(), val.Address, h.Denom())
+		require.NoError(t, err)
+		require.NoError(t, eth.SendToCosmos(eth.MinerKey, val.Address, amount))
+		h.WaitFor("the deposit", func(ctx context.Context) (bool, error) {
+			balance, err := h.Chain.Balance(ctx, val.Address, h.Denom())
+			if err != nil {
+				return false, err
+			}
+			return balance.Amount.Sub(before.Amount).Equal(sdk.NewInt(amount)), nil
+		})
+	}
+
+	sendToEthereum := func(t *testing.T, recipient common.Address, amount int64) {
+		_, err := h.Chain.BroadcastTx(val,
+			&types.MsgSendToEthereum{
+				Sender:            val.Address.String(),
+				EthereumRecipient: recipient.Hex(),
+				Amount:            sdk.NewInt64Coin(h.Denom(), amount),
+				BridgeFee:         sdk.NewInt64Coin(h.Denom(), 1),
+			},
+			&types.MsgRequestBatchTx{Denom: h.Denom(), Signer: val.Address.String()},
+		)
+		require.NoError(t, err)
+	}
+
+	t.Run("deposit", func(t *testing.T) {
+		deposit(t, 1000)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		recipient := crypto.PubkeyToAddress(h.Orchestrators[1].EthKey.PublicKey)
+		sendToEthereum(t, recipient, 100)
+
+		h.WaitFor("the batch to be executed", func(ctx context.Context) (bool, error) {
+			balance, err := eth.TokenBalance(recipient)
+			if err != nil {
+				return false, err
+			}
+			res, err := queryClient.BatchTxs(ctx, &types.BatchTxsRequest{})
+			if err != nil {
+				return false, err
+			}
+			return balance.Cmp(big.NewInt(100)) == 0 && len(res.Batches) == 0, nil
+		})
+	})
+
+	t.Run("signer set", func(t *testing.T) {
+		first := latestSignerSetTx(t, h)
+		delegate(t, h)
+
+		h.WaitFor("the new signer set to be relayed and observed", func(ctx context.Context) (bool, error) {
+			latest := latestSignerSetTx(t, h)
+			if latest.Nonce == first.Nonce {
+				return false, nil
+			}
+			nonce, err := eth.LastValsetNonce()
+			if err != nil || nonce != latest.Nonce {
+				return false, err
+			}
+			// the oracle observed the update once every validator submitted it
+			events, _, err := eth.Events(ctx, 0, eth.Height())
+			if err != nil {
+				return false, err
+			}
+			for _, orch := range h.Orchestrators {
+				res, err := queryClient.LastSubmittedEthereumEvent(ctx, &types.LastSubmittedEthereumEventRequest{Address: orch.Validator.Address.String()})
+				if err != nil || res.EventNonce != events[len(events)-1].GetEventNonce() {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+	})
+
+	t.Run("batch timeout", func(t *testing.T) {
+		h.RelayerPaused = true
+		defer func() { h.RelayerPaused = false }()
+
+		recipient := crypto.PubkeyToAddress(h.Orchestrators[2].EthKey.PublicKey)
+		sendToEthereum(t, recipient, 100)
+
+		var batch *types.BatchTx
+		h.WaitFor("the batch to be signed", func(ctx context.Context) (bool, error) {
+			res, err := queryClient.BatchTxs(ctx, &types.BatchTxsRequest{})
+			if err != nil || len(res.Batches) == 0 {
+				return false, err
+			}
+			batch = res.Batches[0]
+			confirmations, err := queryClient.BatchTxConfirmations(ctx, &types.BatchTxConfirmationsRequest{
+				BatchNonce:    batch.BatchNonce,
+				TokenContract: batch.TokenContract,
+			})
+			if err != nil {
+				return false, err
+			}
+			return len(confirmations.Signatures) == len(h.Orchestrators), nil
+		})
+
+		// the chain only learns about the ethereum height from observed
+		// events, so a deposit after the timeout cancels the batch
+		eth.Commit(int(batch.Timeout - eth.Height() + 1))
+		deposit(t, 1)
+
+		h.WaitFor("the batch to be canceled", func(ctx context.Context) (bool, error) {
+			res, err := queryClient.BatchTxs(ctx, &types.BatchTxsRequest{})
+			if err != nil || len(res.Batches) != 0 {
+				return false, err
+			}
+			unbatched, err := queryClient.UnbatchedSendToEthereums(ctx, &types.UnbatchedSendToEthereumsRequest{})
+			if err != nil {
+				return false, err
+			}
+			return len(unbatched.SendToEthereums) == len(batch.Transactions), nil
+		})
+
+		balance, err := eth.TokenBalance(recipient)
+		require.NoError(t, err)
+		require.Zero(t, balance.Sign())
+	})
+}
@@ -0,0 +1,278 @@
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// Orchestrator runs the duties of the orchestrator of a validator. The
+// validator account doubles as the orchestrator account.
+type Orchestrator struct {
+	Validator *network.Validator
+	EthKey    *ecdsa.PrivateKey
+
+	chain     *Chain
+	gravityID string
+	delegated bool
+}
+
+// NewOrchestrator returns an orchestrator of the validator with a new
+// ethereum key
+func NewOrchestrator(chain *Chain, val *network.Validator, gravityID string) (*Orchestrator, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Orchestrator{Validator: val, EthKey: key, chain: chain, gravityID: gravityID}, nil
+}
+
+// EthAddress returns the address of the ethereum key
+func (o *Orchestrator) EthAddress() common.Address {
+	return crypto.PubkeyToAddress(o.EthKey.PublicKey)
+}
+
+// DelegateKeys registers the orchestrator and ethereum keys of the validator
+func (o *Orchestrator) DelegateKeys() error {
+	// the msg server verifies the signature with the sequence of the account
+	// before the tx
+	seq, err := o.chain.Sequence(o.Validator)
+	if err != nil {
+		return err
+	}
+	signMsgBz, err := (&types.DelegateKeysSignMsg{
+		ValidatorAddress: o.Validator.ValAddress.String(),
+		Nonce:            seq,
+	}).Marshal()
+	if err != nil {
+		return err
+	}
+	signature, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), o.EthKey)
+	if err != nil {
+		return err
+	}
+
+	msg := types.NewMsgDelegateKeys(o.Validator.ValAddress, o.Validator.Address, o.EthAddress().Hex(), signature)
+	if _, err := o.chain.BroadcastTx(o.Validator, msg); err != nil {
+		return err
+	}
+	o.delegated = true
+	return nil
+}
+
+// Tick delegates the keys of the validator once, signs every outgoing tx it
+// hasn't signed yet and, if eth is not nil, submits the ethereum events it
+// hasn't submitted yet
+func (o *Orchestrator) Tick(ctx context.Context, eth *Ethereum) error {
+	if !o.delegated {
+		return o.DelegateKeys()
+	}
+
+	confirmations, err := o.confirmations(ctx)
+	if err != nil {
+		return err
+	}
+	// whatever doesn't fit in the messages is submitted on the next tick
+	if len(confirmations) > types.MaxEthereumTxConfirmationsPerMsg {
+		confirmations = confirmations[:types.MaxEthereumTxConfirmationsPerMsg]
+	}
+	var msgs []sdk.Msg
+	if len(confirmations) > 0 {
+		msg := &types.MsgSubmitEthereumTxConfirmations{Signer: o.Validator.Address.String()}
+		for _, confirmation := range confirmations {
+			any, err := types.PackConfirmation(confirmation)
+			if err != nil {
+				return err
+			}
+			msg.Confirmations = append(msg.Confirmations, any)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if eth != nil {
+		events, err := o.unsubmittedEvents(ctx, eth)
+		if err != nil {
+			return err
+		}
+		if len(events) > types.MaxEthereumEventsPerMsg {
+			events = events[:types.MaxEthereumEventsPerMsg]
+		}
+		if len(events) > 0 {
+			msg := &types.MsgSubmitEthereumEvents{Signer: o.Validator.Address.String()}
+			for _, event := range events {
+				any, err := types.PackEvent(event)
+				if err != nil {
+					return err
+				}
+				msg.Events = append(msg.Events, any)
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	_, err = o.chain.BroadcastTx(o.Validator, msgs...)
+	return err
+}
+
+// confirmations signs the signer set txs and batches the validator hasn't
+// signed yet
+func (o *Orchestrator) confirmations(ctx context.Context) ([]types.EthereumTxConfirmation, error) {
+	queryClient := o.chain.QueryClient()
+	address := o.Validator.Address.String()
+	signer := o.EthAddress().Hex()
+
+	var confirmations []types.EthereumTxConfirmation
+
+	signerSets, err := queryClient.UnsignedSignerSetTxs(ctx, &types.UnsignedSignerSetTxsRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	for _, signerSet := range signerSets.SignerSets {
+		signature, err := types.NewEthereumSignature(signerSet.GetCheckpoint([]byte(o.gravityID)), o.EthKey)
+		if err != nil {
+			return nil, err
+		}
+		confirmations = append(confirmations, &types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSet.Nonce,
+			EthereumSigner: signer,
+			Signature:      signature,
+		})
+	}
+
+	batches, err := queryClient.UnsignedBatchTxs(ctx, &types.UnsignedBatchTxsRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range batches.Batches {
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint([]byte(o.gravityID)), o.EthKey)
+		if err != nil {
+			return nil, err
+		}
+		confirmations = append(confirmations, &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: signer,
+			Signature:      signature,
+		})
+	}
+
+	return confirmations, nil
+}
+
+// unsubmittedEvents returns the events of the contract after the last event
+// the validator submitted
+func (o *Orchestrator) unsubmittedEvents(ctx context.Context, eth *Ethereum) ([]types.EthereumEvent, error) {
+	res, err := o.chain.QueryClient().LastSubmittedEthereumEvent(ctx, &types.LastSubmittedEthereumEventRequest{
+		Address: o.Validator.Address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, _, err := eth.Events(ctx, 0, eth.Height())
+	if err != nil {
+		return nil, err
+	}
+	var unsubmitted []types.EthereumEvent
+	for _, event := range events {
+		if event.GetEventNonce() > res.EventNonce {
+			unsubmitted = append(unsubmitted, event)
+		}
+	}
+	return unsubmitted, nil
+}
+
+// Relayer submits signer set updates and batches to the contract once enough
+// validators signed them
+type Relayer struct {
+	Key *ecdsa.PrivateKey
+
+	chain *Chain
+	eth   *Ethereum
+}
+
+// Relay submits the latest signer set tx if it is newer than the contract's
+// signer set and then every batch which is newer than the last batch the
+// contract executed and hasn't timed out
+func (r *Relayer) Relay(ctx context.Context) error {
+	_, current, err := r.eth.Events(ctx, 0, r.eth.Height())
+	if err != nil || current == nil {
+		return err
+	}
+	queryClient := r.chain.QueryClient()
+
+	latest, err := queryClient.LatestSignerSetTx(ctx, &types.LatestSignerSetTxRequest{})
+	if err != nil {
+		return err
+	}
+	if signerSet := latest.SignerSet; signerSet != nil && signerSet.Nonce > current.nonce {
+		res, err := queryClient.SignerSetTxConfirmations(ctx, &types.SignerSetTxConfirmationsRequest{SignerSetNonce: signerSet.Nonce})
+		if err != nil {
+			return err
+		}
+		signatures := make(map[string][]byte)
+		for _, confirmation := range res.Signatures {
+			signatures[common.HexToAddress(confirmation.EthereumSigner).Hex()] = confirmation.Signature
+		}
+		if hasPowerThreshold(current.signers, signatures) {
+			if err := r.eth.UpdateValset(r.Key, current, signerSet, signatures); err != nil {
+				return err
+			}
+			current = &contractSignerSet{nonce: signerSet.Nonce, signers: signerSet.Signers}
+		}
+	}
+
+	batches, err := queryClient.BatchTxs(ctx, &types.BatchTxsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, batch := range batches.Batches {
+		lastNonce, err := r.eth.LastBatchNonce(common.HexToAddress(batch.TokenContract))
+		if err != nil {
+			return err
+		}
+		if batch.BatchNonce <= lastNonce || batch.Timeout <= r.eth.Height() {
+			continue
+		}
+
+		res, err := queryClient.BatchTxConfirmations(ctx, &types.BatchTxConfirmationsRequest{
+			BatchNonce:    batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+		})
+		if err != nil {
+			return err
+		}
+		signatures := make(map[string][]byte)
+		for _, confirmation := range res.Signatures {
+			signatures[common.HexToAddress(confirmation.EthereumSigner).Hex()] = confirmation.Signature
+		}
+		if !hasPowerThreshold(current.signers, signatures) {
+			continue
+		}
+		if err := r.eth.SubmitBatch(r.Key, current, batch, signatures); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasPowerThreshold returns true if the signers with a signature have more
+// power than the contract requires
+func hasPowerThreshold(signers types.EthereumSigners, signatures map[string][]byte) bool {
+	var power uint64
+	for _, signer := range signers {
+		if _, ok := signatures[common.HexToAddress(signer.EthereumAddress).Hex()]; ok {
+			power += signer.Power
+		}
+	}
+	return power > types.EthereumSignerPowerThreshold
+}
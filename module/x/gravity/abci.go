@@ -28,6 +28,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	outgoingTxSlashing(ctx, k)
 	eventVoteRecordTally(ctx, k)
+	k.RefundExpiredQuarantinedDeposits(ctx)
 }
 
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
@@ -46,40 +47,27 @@ func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 		sort.Strings(contracts)
 
 		for _, c := range contracts {
-			// NOTE: this doesn't emit events which would be helpful for client processes
 			k.BuildBatchTx(ctx, common.HexToAddress(c), 100)
 		}
 	}
 }
 
 func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
-	// Auto signerset tx creation.
-	// 1. If there are no signer set requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
-	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
-	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
-	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
-	if latestSignerSetTx == nil {
-		k.CreateSignerSetTx(ctx)
-		return
-	}
-
-	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
-	blockHeight := uint64(ctx.BlockHeight())
-	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
-
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	decision := k.SignerSetTxDecision(ctx)
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
-		"blockHeight", blockHeight,
-		"lastUnbondingHeight", lastUnbondingHeight,
-		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
-		"powerDiff", powerDiff,
-		"shouldCreate", shouldCreate,
+		"blockHeight", decision.BlockHeight,
+		"latestSignerSetTx.Nonce", decision.LatestSignerSetTxNonce,
+		"powerDiff", decision.PowerDiff,
+		"validatorUnbonding", decision.ValidatorUnbonding,
+		"membersChanged", decision.MembersChanged,
+		"ethereumAddressChanged", decision.EthereumAddressChanged,
+		"expired", decision.Expired,
+		"tooSoon", decision.TooSoon,
+		"shouldCreate", decision.Create,
 	)
 
-	if shouldCreate {
+	if decision.Create {
 		k.CreateSignerSetTx(ctx)
 	}
 }
@@ -105,49 +93,11 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
-// "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// Iterate over the attestations at the nonces after the last observed event
+// and "Observe" those who have passed the threshold. Break the loop once we
+// see a nonce without an attestation that has passed the threshold
 func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetEthereumEventVoteRecordMapping(ctx)
-
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryEventVoteRecord(ctx, att)
-			}
-		}
-	}
+	k.TallyEventVoteRecords(ctx)
 }
 
 // cleanupTimedOutBatchTxs deletes batches that have passed their expiration on Ethereum
@@ -166,6 +116,7 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 
 		if btx.Timeout < ethereumHeight {
 			k.CancelBatchTx(ctx, common.HexToAddress(btx.TokenContract), btx.BatchNonce)
+			k.AfterBatchTxTimedOut(ctx, *btx)
 		}
 
 		return false
@@ -187,8 +138,12 @@ func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 		cctx, _ := otx.(*types.ContractCallTx)
 		if cctx.Timeout < ethereumHeight {
 			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			k.EmitTypedEvent(ctx, &types.EventContractCallTxTimedOut{
+				InvalidationScope: cctx.InvalidationScope,
+				InvalidationNonce: cctx.InvalidationNonce,
+			})
 		}
-		return true
+		return false
 	})
 }
 
@@ -255,35 +210,20 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
+	signerSetTxs := k.GetSignerSetTxIndex(ctx)
 	for _, otx := range usotxs {
-		// SLASH BONDED VALIDATORS who didn't sign batch txs
+		// COUNT the outgoing txs BONDED VALIDATORS signed or missed over the signing window
 		signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
+		required := requiredSigners(signerSetTxs, otx)
 		for _, valInfo := range valInfos {
-			// Don't slash validators who joined after outgoingtx is created
+			// Don't count outgoing txs of validators outside of the signer set
+			if required != nil && !required[k.GetValidatorEthereumAddress(ctx, valInfo.val.GetOperator()).Hex()] {
+				continue
+			}
+			// Don't count outgoing txs created before the validator joined
 			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
-				if _, ok := signatures[valInfo.val.GetOperator().String()]; !ok {
-					if !valInfo.val.IsJailed() {
-						power := valInfo.val.ConsensusPower(k.PowerReduction)
-						k.StakingKeeper.Slash(
-							ctx,
-							valInfo.cons,
-							ctx.BlockHeight(),
-							power,
-							params.SlashFractionBatch,
-						)
-						k.StakingKeeper.Jail(ctx, valInfo.cons)
-
-						ctx.EventManager().EmitEvent(
-							sdk.NewEvent(
-								slashingtypes.EventTypeSlash,
-								sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-								sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-							),
-						)
-					}
-				}
+				_, signed := signatures[valInfo.val.GetOperator().String()]
+				k.HandleOutgoingTxSignature(ctx, valInfo.val, valInfo.cons, signed, otx)
 			}
 		}
 
@@ -298,25 +238,7 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 					if _, found := signatures[valInfo.val.GetOperator().String()]; !found {
 						if !valInfo.val.IsJailed() {
 							// TODO: Do we want to slash jailed validators?
-							power := valInfo.val.ConsensusPower(k.PowerReduction)
-							k.StakingKeeper.Slash(
-								ctx,
-								valInfo.cons,
-								ctx.BlockHeight(),
-								power,
-								params.SlashFractionSignerSetTx,
-							)
-							k.StakingKeeper.Jail(ctx, valInfo.cons)
-
-							ctx.EventManager().EmitEvent(
-								sdk.NewEvent(
-									slashingtypes.EventTypeSlash,
-									sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-									sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-								),
-							)
+							k.SlashValidator(ctx, valInfo.val, valInfo.cons, params.SlashFractionUnbondingSignerSetTx, types.AttributeMissingBridgeSignerSetSig, otx)
 						}
 					}
 				}
@@ -327,3 +249,18 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		k.SetLastSlashedOutgoingTxBlockHeight(ctx, otx.GetCosmosHeight())
 	}
 }
+
+// requiredSigners returns the ethereum addresses of the signer set the
+// outgoing tx is signed for, or nil if every validator must sign it
+func requiredSigners(signerSetTxs keeper.SignerSetTxIndex, otx types.OutgoingTx) map[string]bool {
+	signers := signerSetTxs.RequiredSigners(otx)
+	if signers == nil {
+		return nil
+	}
+	required := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		required[common.HexToAddress(signer.EthereumAddress).Hex()] = true
+	}
+	return required
+}
+
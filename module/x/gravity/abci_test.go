@@ -72,18 +72,24 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
 	params := input.GravityKeeper.GetParams(ctx)
+	// a validator missing both outgoing txs of the window is jailed
+	params.OutgoingTxSigningWindow = 2
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	input.SetGravityParams(ctx, params)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedSignerSetTxsWindow) + 2)
-	signerSet := pk.CreateSignerSetTx(ctx)
 	height := uint64(ctx.BlockHeight()) - (params.SignedSignerSetTxsWindow + 1)
-	signerSet.Height = height
-	pk.SetOutgoingTx(ctx, signerSet)
-
-	for i, val := range keeper.ValAddrs {
-		if i == 0 {
-			continue
+	for n := 0; n < 2; n++ {
+		signerSet := pk.CreateSignerSetTx(ctx)
+		signerSet.Height = height
+		pk.SetOutgoingTx(ctx, signerSet)
+
+		for i, val := range keeper.ValAddrs {
+			if i == 0 {
+				continue
+			}
+			pk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{SignerSetNonce: signerSet.Nonce, EthereumSigner: keeper.AccAddrs[i].String(), Signature: []byte("dummysig")}, val)
 		}
-		pk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{signerSet.Nonce, keeper.AccAddrs[i].String(), []byte("dummysig")}, val)
 	}
 
 	gravity.EndBlocker(ctx, pk)
@@ -161,41 +167,100 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 	// check if tokens shouldn't be slashed for val2.
 }
 
-func TestBatchSlashing(t *testing.T) {
+func TestSignerSetTxSlashing_UnbondingValidator_SlashFraction(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
 	params := gravityKeeper.GetParams(ctx)
 
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+	// the validator with the least power drops out of the active set
+	stakingParams := input.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 4
+	input.StakingKeeper.SetParams(ctx, stakingParams)
 
-	// First store a batch
-	batch := &types.BatchTx{
-		BatchNonce:    1,
-		Transactions:  []*types.SendToEthereum{},
-		TokenContract: keeper.TokenContractAddrs[0],
-		Height:        uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
-	}
-	gravityKeeper.SetOutgoingTx(ctx, batch)
+	signerSetTxHeight := ctx.BlockHeight() + 1
+	ctx = ctx.WithBlockHeight(signerSetTxHeight)
+	vs := gravityKeeper.CreateSignerSetTx(ctx)
+	vs.Height = uint64(signerSetTxHeight)
+	vs.Nonce = uint64(signerSetTxHeight)
+	gravityKeeper.SetOutgoingTx(ctx, vs)
+
+	input.Context = ctx.WithBlockHeight(signerSetTxHeight + 1)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Context, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount.QuoRaw(2)))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Context, input.StakingKeeper)
+	val := input.StakingKeeper.Validator(input.Context, keeper.ValAddrs[0])
+	require.True(t, val.IsUnbonding())
+	require.False(t, val.IsJailed())
 
 	for i, val := range keeper.ValAddrs {
 		if i == 0 {
-			// don't sign with first validator
 			continue
 		}
-		if i == 1 {
-			// don't sign with 2nd validator. set val bond height > batch block height
-			validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i])
-			valConsAddr, _ := validator.GetConsAddr()
-			valSigningInfo := slashingtypes.ValidatorSigningInfo{StartHeight: int64(batch.Height + 1)}
-			input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, valSigningInfo)
-			continue
+		gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{SignerSetNonce: vs.Nonce, EthereumSigner: keeper.EthAddrs[i].Hex(), Signature: []byte("dummySig")}, val)
+	}
+
+	ctx = ctx.WithBlockHeight(signerSetTxHeight + int64(params.SignedSignerSetTxsWindow) + 1).WithEventManager(sdk.NewEventManager())
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	// the unbonding validator is slashed with the slash fraction of unbonding
+	// validators
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	var slashed []*types.EventValidatorSlashed
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			if e, ok := msg.(*types.EventValidatorSlashed); ok {
+				slashed = append(slashed, e)
+			}
+		}
+	}
+	require.Len(t, slashed, 1)
+	require.Equal(t, keeper.ValAddrs[0].String(), slashed[0].Validator)
+	require.Equal(t, params.SlashFractionUnbondingSignerSetTx, slashed[0].SlashFraction)
+}
+
+func TestBatchSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	// a validator missing both outgoing txs of the window is jailed
+	params.OutgoingTxSigningWindow = 2
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	input.SetGravityParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+
+	// First store the batches
+	var batch *types.BatchTx
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		batch = &types.BatchTx{
+			BatchNonce:    nonce,
+			Transactions:  []*types.SendToEthereum{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Height:        uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+		}
+		gravityKeeper.SetOutgoingTx(ctx, batch)
+
+		for i, val := range keeper.ValAddrs {
+			if i == 0 {
+				// don't sign with first validator
+				continue
+			}
+			if i == 1 {
+				// don't sign with 2nd validator. set val bond height > batch block height
+				validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i])
+				valConsAddr, _ := validator.GetConsAddr()
+				valSigningInfo := slashingtypes.ValidatorSigningInfo{StartHeight: int64(batch.Height + 1)}
+				input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, valSigningInfo)
+				continue
+			}
+			gravityKeeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				BatchNonce:     batch.BatchNonce,
+				TokenContract:  keeper.TokenContractAddrs[0],
+				EthereumSigner: keeper.EthAddrs[i].String(),
+				Signature:      []byte("dummysig"),
+			}, val)
 		}
-		gravityKeeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
-			BatchNonce:     batch.BatchNonce,
-			TokenContract:  keeper.TokenContractAddrs[0],
-			EthereumSigner: keeper.EthAddrs[i].String(),
-			Signature:      []byte("dummysig"),
-		}, val)
 	}
 
 	gravity.EndBlocker(ctx, gravityKeeper)
@@ -314,3 +379,132 @@ func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddre
 
 	return bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amounts)
 }
+
+func TestBatchSlashingOnlyRequiredSigners(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	// a validator missing two of the three outgoing txs of the window is jailed
+	params.OutgoingTxSigningWindow = 3
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	input.SetGravityParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+	height := uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1))
+
+	// the signer set in force when the batch is created leaves out the first validator
+	signerSet := gravityKeeper.CreateSignerSetTx(ctx)
+	signerSet.Height = height
+	for i, signer := range signerSet.Signers {
+		if signer.EthereumAddress == keeper.EthAddrs[0].Hex() {
+			signerSet.Signers = append(signerSet.Signers[:i], signerSet.Signers[i+1:]...)
+			break
+		}
+	}
+	gravityKeeper.SetOutgoingTx(ctx, signerSet)
+	for i, val := range keeper.ValAddrs {
+		gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{SignerSetNonce: signerSet.Nonce, EthereumSigner: keeper.EthAddrs[i].Hex(), Signature: []byte("dummysig")}, val)
+	}
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		gravityKeeper.SetOutgoingTx(ctx, &types.BatchTx{
+			BatchNonce:    nonce,
+			Transactions:  []*types.SendToEthereum{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Height:        height,
+		})
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	// only the validators in the signer set are slashed for not signing the batch
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	for _, val := range keeper.ValAddrs[1:] {
+		require.True(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+}
+
+func TestSignerSetTxCreationUponMembershipChange(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	// a validator with little power has left since the latest signer set
+	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	sstx.Signers = append(sstx.Signers, &types.EthereumSigner{Power: 1, EthereumAddress: common.HexToAddress("0x01").Hex()})
+	gravityKeeper.SetOutgoingTx(ctx, sstx)
+
+	// an uncapped signer set only changes on power changes
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, len(gravityKeeper.GetSignerSetTxs(ctx)))
+
+	params := gravityKeeper.GetParams(ctx)
+	params.MaxEthereumSigners = 5
+	input.SetGravityParams(ctx, params)
+
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+}
+
+func TestBatchSlashingWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+	params.OutgoingTxSigningWindow = 10
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	input.SetGravityParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 2)
+
+	// an orchestrator outage misses several batches at once
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		gravityKeeper.SetOutgoingTx(ctx, &types.BatchTx{
+			BatchNonce:    nonce,
+			Transactions:  []*types.SendToEthereum{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Height:        uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+		})
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	// the misses are counted over the window instead of slashing each of them
+	for _, val := range keeper.ValAddrs {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+		require.EqualValues(t, 3, gravityKeeper.GetOutgoingTxSigningInfo(ctx, val).MissedOutgoingTxsCounter)
+	}
+}
+
+func TestContractCallTxTimeout(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	params := gravityKeeper.GetParams(ctx)
+
+	scope := []byte("scope")
+	cctx := gravityKeeper.CreateContractCallTx(ctx, 1, scope, []byte("payload"), nil, nil)
+	next := gravityKeeper.CreateContractCallTx(ctx, 2, scope, []byte("payload"), nil, nil)
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, cctx.GetStoreIndex()))
+
+	// the contract call stays until its timeout passed on ethereum
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, params.TargetEthTxTimeout)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, cctx.GetStoreIndex()))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, params.TargetEthTxTimeout+1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Nil(t, gravityKeeper.GetOutgoingTx(ctx, cctx.GetStoreIndex()))
+	require.Nil(t, gravityKeeper.GetOutgoingTx(ctx, next.GetStoreIndex()))
+
+	var timedOut []*types.EventContractCallTxTimedOut
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			if e, ok := msg.(*types.EventContractCallTxTimedOut); ok {
+				timedOut = append(timedOut, e)
+			}
+		}
+	}
+	require.ElementsMatch(t, []*types.EventContractCallTxTimedOut{
+		{InvalidationScope: scope, InvalidationNonce: 1},
+		{InvalidationScope: scope, InvalidationNonce: 2},
+	}, timedOut)
+}
@@ -0,0 +1,301 @@
+package cli
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagPassphraseFile is the flag used to read an ethereum keystore passphrase
+	// from a file instead of prompting for it
+	FlagPassphraseFile = "passphrase-file"
+
+	// FlagEthKeyringBackend is the flag used to select the backend of the
+	// ethereum keyring when it differs from --keyring-backend
+	FlagEthKeyringBackend = "eth-keyring-backend"
+
+	// legacyKeyPrefix is the file name prefix of the keys written by the geth
+	// keystore directly in the client keyring directory, before keys were
+	// named and kept per backend
+	legacyKeyPrefix = "UTC--"
+
+	// legacyPassphrase is the default passphrase legacy keys were encrypted
+	// with
+	legacyPassphrase = "default"
+)
+
+// EthereumKeyInfo describes a key stored in the ethereum keyring
+type EthereumKeyInfo struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// EthereumKeyring stores named ethereum keys as geth JSON keystore files in a
+// per keyring backend directory under the client keyring directory. The test
+// backend stores keys with an empty passphrase and never prompts, the file
+// backend encrypts keys on disk with a passphrase which is read from
+// --passphrase-file or prompted for. Ethereum keys can't be stored by the
+// operating system, so the os backend isn't supported.
+//
+// Keys written by the geth keystore directly in the client keyring directory
+// by earlier versions are still found, named by their key file.
+type EthereumKeyring struct {
+	dir            string
+	legacyDir      string
+	backend        string
+	passphraseFile string
+	buf            *bufio.Reader
+}
+
+// NewEthereumKeyringFromCmd returns the ethereum keyring selected by the
+// --keyring-dir, --eth-keyring-backend or --keyring-backend and
+// --passphrase-file flags of cmd
+func NewEthereumKeyringFromCmd(cmd *cobra.Command) (*EthereumKeyring, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, _ := cmd.Flags().GetString(FlagEthKeyringBackend)
+	if backend == "" {
+		backend, _ = cmd.Flags().GetString(flags.FlagKeyringBackend)
+	}
+	switch backend {
+	case keyring.BackendTest, keyring.BackendFile:
+	case keyring.BackendOS:
+		return nil, fmt.Errorf("the %s keyring backend can't store ethereum keys, use %s or %s", backend, keyring.BackendFile, keyring.BackendTest)
+	default:
+		return nil, fmt.Errorf("unsupported keyring backend %s for ethereum keys", backend)
+	}
+	passphraseFile, _ := cmd.Flags().GetString(FlagPassphraseFile)
+
+	return &EthereumKeyring{
+		dir:            filepath.Join(clientCtx.KeyringDir, "keyring-eth-"+backend),
+		legacyDir:      clientCtx.KeyringDir,
+		backend:        backend,
+		passphraseFile: passphraseFile,
+		buf:            bufio.NewReader(cmd.InOrStdin()),
+	}, nil
+}
+
+// Reader returns the buffered input the keyring prompts on
+func (kr *EthereumKeyring) Reader() *bufio.Reader {
+	return kr.buf
+}
+
+// Passphrase returns the passphrase protecting keys in the keyring. If confirm
+// is set a prompted passphrase has to be entered twice.
+func (kr *EthereumKeyring) Passphrase(prompt string, confirm bool) (string, error) {
+	if kr.backend == keyring.BackendTest {
+		return "", nil
+	}
+
+	if kr.passphraseFile != "" {
+		bz, err := ioutil.ReadFile(kr.passphraseFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(bz), "\r\n"), nil
+	}
+
+	passphrase, err := input.GetPassword(prompt, kr.buf)
+	if err != nil {
+		return "", err
+	}
+	if confirm {
+		again, err := input.GetPassword("Repeat the passphrase:", kr.buf)
+		if err != nil {
+			return "", err
+		}
+		if passphrase != again {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+	return passphrase, nil
+}
+
+// List returns all keys in the keyring sorted by name, including the legacy
+// keys which aren't shadowed by a key of the same name
+func (kr *EthereumKeyring) List() ([]EthereumKeyInfo, error) {
+	infos, err := listKeys(kr.dir, "")
+	if err != nil {
+		return nil, err
+	}
+	legacyInfos, err := listKeys(kr.legacyDir, legacyKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, legacyInfo := range legacyInfos {
+		if _, err := os.Stat(filepath.Join(kr.dir, legacyInfo.Name)); os.IsNotExist(err) {
+			infos = append(infos, legacyInfo)
+		}
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+// listKeys returns the keys stored in the files of dir whose name has prefix
+func listKeys(dir, prefix string) ([]EthereumKeyInfo, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var infos []EthereumKeyInfo
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		bz, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var key struct {
+			Address string `json:"address"`
+		}
+		if err := json.Unmarshal(bz, &key); err != nil || !common.IsHexAddress(key.Address) {
+			continue
+		}
+		infos = append(infos, EthereumKeyInfo{
+			Name:    entry.Name(),
+			Address: common.HexToAddress(key.Address).Hex(),
+		})
+	}
+	return infos, nil
+}
+
+// Get returns the key with the given name or ethereum address
+func (kr *EthereumKeyring) Get(nameOrAddress string) (EthereumKeyInfo, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return EthereumKeyInfo{}, err
+	}
+	for _, info := range infos {
+		if info.Name == nameOrAddress {
+			return info, nil
+		}
+		if common.IsHexAddress(nameOrAddress) && common.HexToAddress(nameOrAddress).Hex() == info.Address {
+			return info, nil
+		}
+	}
+	return EthereumKeyInfo{}, fmt.Errorf("ethereum key %s not found", nameOrAddress)
+}
+
+// Import encrypts the private key with the passphrase and stores it under name
+func (kr *EthereumKeyring) Import(name string, privateKey *ecdsa.PrivateKey, passphrase string) (EthereumKeyInfo, error) {
+	if err := kr.checkNewName(name); err != nil {
+		return EthereumKeyInfo{}, err
+	}
+
+	account, err := kr.keystore().ImportECDSA(privateKey, passphrase)
+	if err != nil {
+		return EthereumKeyInfo{}, err
+	}
+	return kr.rename(name, account.URL.Path, account.Address)
+}
+
+// ImportKeystore re-encrypts the JSON keystore with the passphrase and stores
+// it under name
+func (kr *EthereumKeyring) ImportKeystore(name string, keyJSON []byte, keyJSONPassphrase, passphrase string) (EthereumKeyInfo, error) {
+	if err := kr.checkNewName(name); err != nil {
+		return EthereumKeyInfo{}, err
+	}
+
+	account, err := kr.keystore().Import(keyJSON, keyJSONPassphrase, passphrase)
+	if err != nil {
+		return EthereumKeyInfo{}, err
+	}
+	return kr.rename(name, account.URL.Path, account.Address)
+}
+
+// PrivateKey decrypts and returns the private key with the given name or
+// ethereum address
+func (kr *EthereumKeyring) PrivateKey(nameOrAddress, passphrase string) (*ecdsa.PrivateKey, error) {
+	info, err := kr.Get(nameOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	path, legacy := kr.keyPath(info.Name)
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// legacy keys were encrypted with a passphrase whatever the backend
+	if legacy && kr.backend == keyring.BackendTest {
+		passphrase = legacyPassphrase
+	}
+	key, err := keystore.DecryptKey(bz, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt ethereum key %s: %w", info.Name, err)
+	}
+	return key.PrivateKey, nil
+}
+
+// Delete removes the key with the given name or ethereum address
+func (kr *EthereumKeyring) Delete(nameOrAddress string) error {
+	info, err := kr.Get(nameOrAddress)
+	if err != nil {
+		return err
+	}
+	path, _ := kr.keyPath(info.Name)
+	return os.Remove(path)
+}
+
+// keyPath returns the path of the key file with the given name and whether it
+// is a legacy key
+func (kr *EthereumKeyring) keyPath(name string) (string, bool) {
+	path := filepath.Join(kr.dir, name)
+	if _, err := os.Stat(path); os.IsNotExist(err) && strings.HasPrefix(name, legacyKeyPrefix) {
+		return filepath.Join(kr.legacyDir, name), true
+	}
+	return path, false
+}
+
+func (kr *EthereumKeyring) keystore() *keystore.KeyStore {
+	if kr.backend == keyring.BackendTest {
+		return keystore.NewKeyStore(kr.dir, keystore.LightScryptN, keystore.LightScryptP)
+	}
+	return keystore.NewKeyStore(kr.dir, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+func (kr *EthereumKeyring) checkNewName(name string) error {
+	switch {
+	case name == "" || strings.HasPrefix(name, "."):
+		return fmt.Errorf("invalid ethereum key name %q", name)
+	case strings.ContainsRune(name, filepath.Separator) || strings.ContainsRune(name, '/'):
+		return fmt.Errorf("invalid ethereum key name %q", name)
+	case common.IsHexAddress(name):
+		return fmt.Errorf("ethereum key name %s cannot be an address", name)
+	}
+	if _, err := os.Stat(filepath.Join(kr.dir, name)); err == nil {
+		return fmt.Errorf("ethereum key %s already exists", name)
+	}
+	return nil
+}
+
+// rename moves a key file written by the geth keystore to its name
+func (kr *EthereumKeyring) rename(name, path string, address common.Address) (EthereumKeyInfo, error) {
+	if err := os.Rename(path, filepath.Join(kr.dir, name)); err != nil {
+		return EthereumKeyInfo{}, err
+	}
+	return EthereumKeyInfo{Name: name, Address: address.Hex()}, nil
+}
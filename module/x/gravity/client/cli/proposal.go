@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// ResolveQuarantinedDepositProposalJSON is the JSON file a quarantined deposit
+// resolution proposal is submitted with
+type ResolveQuarantinedDepositProposalJSON struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	EventNonce     uint64 `json:"event_nonce,string"`
+	CosmosReceiver string `json:"cosmos_receiver"`
+	Deposit        string `json:"deposit"`
+}
+
+func CmdSubmitResolveQuarantinedDepositProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-quarantined-deposit [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to credit or refund a quarantined deposit",
+		Long: fmt.Sprintf(`Submit a proposal to credit a deposit which failed to credit its cosmos receiver
+to another cosmos account, or to send it back to its ethereum sender if the
+cosmos receiver is empty. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal resolve-quarantined-deposit <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Resolve deposit 42",
+  "description": "Credit the deposit to the address its sender asked for",
+  "event_nonce": "42",
+  "cosmos_receiver": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "deposit": "1000stake"
+}`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proposal ResolveQuarantinedDepositProposalJSON
+			if err := json.Unmarshal(bz, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResolveQuarantinedDepositProposal(proposal.Title, proposal.Description, proposal.EventNonce, proposal.CosmosReceiver)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// SetERC20TokenMetadataProposalJSON is the JSON file a token registry
+// proposal is submitted with
+type SetERC20TokenMetadataProposalJSON struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	TokenContract string `json:"token_contract"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	Decimals      uint64 `json:"decimals,string"`
+	Allowed       bool   `json:"allowed"`
+	Deposit       string `json:"deposit"`
+}
+
+func CmdSubmitSetERC20TokenMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-erc20-token-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register an ethereum originated ERC20 token",
+		Long: fmt.Sprintf(`Submit a proposal to set the name, symbol and decimals of an ethereum originated
+ERC20 token in the token registry, and whether its deposits are allowed. The
+vouchers of allowed tokens get bank denom metadata. The proposal details must
+be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-erc20-token-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Allow USDC",
+  "description": "Allow deposits of USDC",
+  "token_contract": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+  "name": "USD Coin",
+  "symbol": "USDC",
+  "decimals": "6",
+  "allowed": true,
+  "deposit": "1000stake"
+}`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proposal SetERC20TokenMetadataProposalJSON
+			if err := json.Unmarshal(bz, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetERC20TokenMetadataProposal(proposal.Title, proposal.Description, types.ERC20TokenMetadata{
+				TokenContract: proposal.TokenContract,
+				Name:          proposal.Name,
+				Symbol:        proposal.Symbol,
+				Decimals:      proposal.Decimals,
+				Allowed:       proposal.Allowed,
+			})
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ApproveCosmosERC20ProposalJSON is the JSON file a cosmos originated ERC20
+// approval proposal is submitted with
+type ApproveCosmosERC20ProposalJSON struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Denom         string `json:"denom"`
+	TokenContract string `json:"token_contract"`
+	Deposit       string `json:"deposit"`
+}
+
+func CmdSubmitApproveCosmosERC20Proposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-cosmos-erc20 [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to approve the ERC20 representation of a cosmos originated denom",
+		Long: fmt.Sprintf(`Submit a proposal to approve a token contract as the ERC20 representation of a
+cosmos originated denom. An observed deployment of the token contract is mapped
+once the proposal passes, remapping the denom if it already has a
+representation, otherwise the deployment is mapped once it's observed. The
+proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal approve-cosmos-erc20 <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Approve the ERC20 representation of stake",
+  "description": "Map stake to its ERC20 deployment",
+  "denom": "stake",
+  "token_contract": "0x2a24af0501a534fca004ee1bd667b783f205a546",
+  "deposit": "1000stake"
+}`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proposal ApproveCosmosERC20ProposalJSON
+			if err := json.Unmarshal(bz, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewApproveCosmosERC20Proposal(proposal.Title, proposal.Description, proposal.Denom, proposal.TokenContract)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdERC20ToDenom(),
 		CmdLastSubmittedEthereumEvent(),
 		CmdLatestSignerSetTx(),
+		CmdLastObservedSignerSetTx(),
 		CmdParams(),
 		CmdSignerSetTx(),
 		CmdSignerSetTxConfirmations(),
@@ -45,6 +46,13 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
+		CmdERC20EscrowBalance(),
+		CmdNextSignerSetTxDecision(),
+		CmdOutgoingTxSigningInfo(),
+		CmdQuarantinedDeposits(),
+		CmdERC20Tokens(),
+		CmdCosmosERC20Approvals(),
+		CmdPendingERC20Deployments(),
 	)
 
 	return gravityQueryCmd
@@ -496,6 +504,32 @@ func CmdLatestSignerSetTx() *cobra.Command {
 	return cmd
 }
 
+func CmdLastObservedSignerSetTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-observed-signer-set-tx",
+		Args:  cobra.NoArgs,
+		Short: "query for the last signer set observed on the gravity contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.LastObservedSignerSetTxRequest{}
+
+			res, err := queryClient.LastObservedSignerSetTx(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdLastSubmittedEthereumEvent() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "last-submitted-ethereum-event [validator-or-orchestrator-acc-address]",
@@ -585,6 +619,38 @@ func CmdERC20ToDenom() *cobra.Command {
 	return cmd
 }
 
+func CmdERC20EscrowBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-escrow-balance [erc20]",
+		Args:  cobra.ExactArgs(1),
+		Short: "given an erc20 contract address return the escrow balance tracked for balance delta accounting",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC20EscrowBalance(cmd.Context(), &types.ERC20EscrowBalanceRequest{
+				TokenContract: contract,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdDenomToERC20Params() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "denom-to-erc20-params [denom]",
@@ -835,3 +901,180 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func CmdNextSignerSetTxDecision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-signer-set-tx-decision",
+		Args:  cobra.NoArgs,
+		Short: "query whether the next block creates a signer set tx and why",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.NextSignerSetTxDecisionRequest{}
+
+			res, err := queryClient.NextSignerSetTxDecision(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOutgoingTxSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-tx-signing-info [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the outgoing txs a validator missed over the signing window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.OutgoingTxSigningInfoRequest{ValidatorAddress: validator.String()}
+
+			res, err := queryClient.OutgoingTxSigningInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuarantinedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Args:  cobra.NoArgs,
+		Short: "query the deposits which failed to credit their cosmos receiver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuarantinedDeposits(cmd.Context(), &types.QuarantinedDepositsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quarantined-deposits")
+	return cmd
+}
+
+func CmdERC20Tokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-tokens",
+		Args:  cobra.NoArgs,
+		Short: "query the token registry entries of ethereum originated ERC20 tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC20Tokens(cmd.Context(), &types.ERC20TokensRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "erc20-tokens")
+	return cmd
+}
+
+func CmdCosmosERC20Approvals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cosmos-erc20-approvals",
+		Args:  cobra.NoArgs,
+		Short: "query the approved ERC20 representations of cosmos originated denoms which aren't deployed yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CosmosERC20Approvals(cmd.Context(), &types.CosmosERC20ApprovalsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cosmos-erc20-approvals")
+	return cmd
+}
+
+func CmdPendingERC20Deployments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-erc20-deployments",
+		Args:  cobra.NoArgs,
+		Short: "query the observed deployments of ERC20 representations which aren't approved",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingERC20Deployments(cmd.Context(), &types.PendingERC20DeploymentsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-erc20-deployments")
+	return cmd
+}
@@ -0,0 +1,319 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	// FlagBridgeFee is the flag used to pass the bridge fee of a send to
+	// ethereum, either as a coin or as auto to estimate it. It is distinct from
+	// the --fees paid for the cosmos tx.
+	FlagBridgeFee = "bridge-fee"
+	// FlagFeePercentile is the percentile of the unbatched pool fees an
+	// estimated bridge fee is picked at
+	FlagFeePercentile = "fee-percentile"
+	// FlagWait is the flag used to follow a send to ethereum until it is
+	// executed on ethereum or cancelled
+	FlagWait = "wait"
+	// FlagWaitInterval is the interval the status of a send to ethereum is
+	// polled at with --wait
+	FlagWaitInterval = "wait-interval"
+
+	bridgeFeeAuto = "auto"
+)
+
+// queryBridgeFee estimates a bridge fee in denom for a transfer to land in the
+// next batch from the fees in the unbatched pool and the pending batches
+func queryBridgeFee(cmd *cobra.Command, clientCtx client.Context, denom string, percentile uint64) (sdk.Coin, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	erc20Res, err := queryClient.DenomToERC20(cmd.Context(), &types.DenomToERC20Request{Denom: denom})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenContract := common.HexToAddress(erc20Res.Erc20)
+	var poolFees []sdk.Int
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{Pagination: pageReq})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		for _, ste := range res.SendToEthereums {
+			if common.HexToAddress(ste.Erc20Fee.Contract) == tokenContract {
+				poolFees = append(poolFees, ste.Erc20Fee.Amount)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	batchFeesRes, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	fee, err := estimateBridgeFee(poolFees, batchTxFeesOf(batchFeesRes.Fees, tokenContract), percentile)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("%s: %w", denom, err)
+	}
+	return sdk.NewCoin(denom, fee), nil
+}
+
+// batchTxFeesOf returns the batched fees paid in the token contract. Batched
+// fees are gravity vouchers of their token contract, whatever the origin of
+// the token.
+func batchTxFeesOf(fees []sdk.Coin, tokenContract common.Address) []sdk.Int {
+	var amounts []sdk.Int
+	for _, fee := range fees {
+		if contract, err := types.GravityDenomToERC20(fee.Denom); err == nil && common.HexToAddress(contract) == tokenContract {
+			amounts = append(amounts, fee.Amount)
+		}
+	}
+	return amounts
+}
+
+// estimateBridgeFee picks the fee at the percentile of the unbatched pool
+// fees. Batches take the highest paying transfers from the pool, so if the pool
+// holds more transfers than fit in a batch the fee is raised above the lowest
+// fee that still makes it into the next batch. Without unbatched transfers the
+// fees of the pending batches are used instead.
+func estimateBridgeFee(poolFees, batchFees []sdk.Int, percentile uint64) (sdk.Int, error) {
+	if percentile > 100 {
+		return sdk.Int{}, fmt.Errorf("fee percentile %d is more than 100", percentile)
+	}
+
+	fees := poolFees
+	if len(fees) == 0 {
+		fees = batchFees
+	}
+	if len(fees) == 0 {
+		return sdk.Int{}, fmt.Errorf("no unbatched or batched transfers to estimate the bridge fee from, pass a fee coin instead")
+	}
+
+	sorted := make([]sdk.Int, len(fees))
+	copy(sorted, fees)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	// nearest rank percentile
+	rank := int(math.Ceil(float64(percentile) / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	fee := sorted[rank-1]
+
+	if len(poolFees) >= keeper.BatchTxSize {
+		if cutoff := sorted[len(sorted)-keeper.BatchTxSize].AddRaw(1); fee.LT(cutoff) {
+			fee = cutoff
+		}
+	}
+
+	return fee, nil
+}
+
+// broadcastSendToEthereum broadcasts the msg like GenerateOrBroadcastTxCLI
+// and returns the id of the send to ethereum created by the tx
+func broadcastSendToEthereum(clientCtx client.Context, cmd *cobra.Command, msg sdk.Msg) (uint64, error) {
+	if clientCtx.GenerateOnly || clientCtx.Offline {
+		return 0, fmt.Errorf("--%s can't be used with --%s or --%s", FlagWait, flags.FlagGenerateOnly, flags.FlagOffline)
+	}
+	if clientCtx.BroadcastMode != flags.BroadcastBlock {
+		return 0, fmt.Errorf("--%s requires --%s=%s", FlagWait, flags.FlagBroadcastMode, flags.BroadcastBlock)
+	}
+
+	var buf bytes.Buffer
+	if err := tx.GenerateOrBroadcastTxCLI(clientCtx.WithOutput(&buf).WithOutputFormat("json"), cmd.Flags(), msg); err != nil {
+		return 0, err
+	}
+
+	var txRes sdk.TxResponse
+	if err := clientCtx.Codec.UnmarshalJSON(buf.Bytes(), &txRes); err != nil {
+		return 0, err
+	}
+	if err := clientCtx.PrintProto(&txRes); err != nil {
+		return 0, err
+	}
+	if txRes.Code != 0 {
+		return 0, fmt.Errorf("send to ethereum failed: %s", txRes.RawLog)
+	}
+
+	bz, err := hex.DecodeString(txRes.Data)
+	if err != nil {
+		return 0, err
+	}
+	var txMsgData sdk.TxMsgData
+	if err := clientCtx.Codec.Unmarshal(bz, &txMsgData); err != nil {
+		return 0, err
+	}
+	if len(txMsgData.Data) != 1 {
+		return 0, fmt.Errorf("expected one msg response, got %d", len(txMsgData.Data))
+	}
+	var res types.MsgSendToEthereumResponse
+	if err := clientCtx.Codec.Unmarshal(txMsgData.Data[0].Data, &res); err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+// waitForSendToEthereum follows a send to ethereum from the unbatched pool
+// through batching and prints every change of its status until it leaves the
+// bridge. A transfer which disappears from a batch was executed on ethereum,
+// one which disappears from the pool was cancelled.
+func waitForSendToEthereum(cmd *cobra.Command, clientCtx client.Context, sender string, id uint64, interval time.Duration) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	out := cmd.OutOrStdout()
+
+	var (
+		lastStatus string
+		inBatch    bool
+	)
+	for {
+		status, batched, err := sendToEthereumStatus(cmd, queryClient, sender, id)
+		if err != nil {
+			return err
+		}
+
+		done := status == ""
+		switch {
+		case !done:
+		case lastStatus == "":
+			return fmt.Errorf("send to ethereum %d not found", id)
+		case inBatch:
+			status = "was executed on ethereum"
+		default:
+			status = "was cancelled"
+		}
+
+		if status != lastStatus {
+			fmt.Fprintf(out, "%s send to ethereum %d %s\n", time.Now().Format(time.RFC3339), id, status)
+			lastStatus = status
+		}
+		if done {
+			return nil
+		}
+		inBatch = batched
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// sendToEthereumStatus returns where the send to ethereum is, or an empty
+// status if it isn't in the pool or a batch
+func sendToEthereumStatus(cmd *cobra.Command, queryClient types.QueryClient, sender string, id uint64) (status string, batched bool, err error) {
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
+			SenderAddress: sender,
+			Pagination:    pageReq,
+		})
+		if err != nil {
+			return "", false, err
+		}
+		for _, ste := range res.SendToEthereums {
+			if ste.Id == id {
+				return "is in the unbatched pool", false, nil
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	pageReq = &query.PageRequest{}
+	for {
+		res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq})
+		if err != nil {
+			return "", false, err
+		}
+		for _, batch := range res.Batches {
+			for _, ste := range batch.Transactions {
+				if ste.Id == id {
+					return fmt.Sprintf("is in batch %d of %s with timeout at ethereum height %d", batch.BatchNonce, batch.TokenContract, batch.Timeout), true, nil
+				}
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	return "", false, nil
+}
+
+// readSendToEthereumEntries reads MsgSendToEthereumMulti entries from CSV
+// lines of ethereum recipient, amount and fee. Every entry is on its own line,
+// so lines are parsed one at a time to report errors with their line number.
+func readSendToEthereumEntries(r io.Reader) ([]types.SendToEthereumEntry, error) {
+	scanner := bufio.NewScanner(r)
+
+	var entries []types.SendToEthereumEntry
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		reader := csv.NewReader(strings.NewReader(text))
+		reader.FieldsPerRecord = 3
+		reader.TrimLeadingSpace = true
+		record, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if !common.IsHexAddress(record[0]) {
+			return nil, fmt.Errorf("line %d: must be a valid ethereum address got %s", line, record[0])
+		}
+		amount, err := sdk.ParseCoinNormalized(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		fee, err := sdk.ParseCoinNormalized(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		entries = append(entries, types.SendToEthereumEntry{
+			EthereumRecipient: common.HexToAddress(record[0]).Hex(),
+			Amount:            amount,
+			BridgeFee:         fee,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) > types.MaxSendToEthereumEntries {
+		return nil, fmt.Errorf("%d entries, at most %d can be sent in one transaction", len(entries), types.MaxSendToEthereumEntries)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestEstimateBridgeFee(t *testing.T) {
+	ints := func(amounts ...int64) (out []sdk.Int) {
+		for _, amount := range amounts {
+			out = append(out, sdk.NewInt(amount))
+		}
+		return out
+	}
+
+	_, err := estimateBridgeFee(nil, nil, 50)
+	require.Error(t, err)
+	_, err = estimateBridgeFee(ints(1), nil, 101)
+	require.Error(t, err)
+
+	// percentiles of the unbatched pool
+	pool := ints(5, 1, 4, 2, 3)
+	for percentile, expected := range map[uint64]int64{0: 1, 20: 1, 50: 3, 90: 5, 100: 5} {
+		fee, err := estimateBridgeFee(pool, ints(100), percentile)
+		require.NoError(t, err)
+		require.Equal(t, expected, fee.Int64(), "percentile %d", percentile)
+	}
+
+	// the pending batches without unbatched transfers
+	fee, err := estimateBridgeFee(nil, ints(7, 9), 50)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), fee.Int64())
+
+	// outbid the lowest fee of a full next batch
+	var full []int64
+	for i := 1; i <= keeper.BatchTxSize*2; i++ {
+		full = append(full, int64(i))
+	}
+	fee, err = estimateBridgeFee(ints(full...), nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(keeper.BatchTxSize+2), fee.Int64())
+	fee, err = estimateBridgeFee(ints(full...), nil, 90)
+	require.NoError(t, err)
+	require.Equal(t, int64(keeper.BatchTxSize*9/5), fee.Int64())
+}
+
+func TestBatchTxFeesOf(t *testing.T) {
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	other := common.HexToAddress("0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7")
+
+	// batched fees are vouchers of their token contract, also for cosmos
+	// originated tokens
+	fees := []sdk.Coin{
+		types.NewERC20Token(3, tokenContract.Hex()).GravityCoin(),
+		types.NewERC20Token(5, other.Hex()).GravityCoin(),
+		sdk.NewInt64Coin("stake", 7),
+		types.NewERC20Token(9, strings.ToLower(tokenContract.Hex())).GravityCoin(),
+	}
+	require.Equal(t, []sdk.Int{sdk.NewInt(3), sdk.NewInt(9)}, batchTxFeesOf(fees, tokenContract))
+}
+
+func TestReadSendToEthereumEntries(t *testing.T) {
+	entries, err := readSendToEthereumEntries(strings.NewReader(`# ethereum-reciever,send-coins,fee-coins
+0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7,1000stake,10stake
+0x69592e6f9d21989a043646fE8225da2600e5A0f7, 2500stake, 0stake
+`))
+	require.NoError(t, err)
+	require.Equal(t, []types.SendToEthereumEntry{
+		{
+			EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			Amount:            sdk.NewInt64Coin("stake", 1000),
+			BridgeFee:         sdk.NewInt64Coin("stake", 10),
+		},
+		{
+			EthereumRecipient: "0x69592e6f9d21989a043646fE8225da2600e5A0f7",
+			Amount:            sdk.NewInt64Coin("stake", 2500),
+			BridgeFee:         sdk.NewInt64Coin("stake", 0),
+		},
+	}, entries)
+
+	_, err = readSendToEthereumEntries(strings.NewReader("0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7,1000stake\n"))
+	require.Error(t, err)
+	_, err = readSendToEthereumEntries(strings.NewReader("0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7,1000stake,10stake\n0x1,1stake,1stake\n"))
+	require.EqualError(t, err, "line 2: must be a valid ethereum address got 0x1")
+
+	// comments and blank lines are counted
+	_, err = readSendToEthereumEntries(strings.NewReader("# header\n\n0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7,1000stake,x\n"))
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "line 3: "), err.Error())
+
+	tooMany := strings.Repeat("0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7,1000stake,10stake\n", types.MaxSendToEthereumEntries+1)
+	_, err = readSendToEthereumEntries(strings.NewReader(tooMany))
+	require.Error(t, err)
+}
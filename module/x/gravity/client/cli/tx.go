@@ -2,19 +2,31 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
 )
 
+// FlagEthKey is the flag used to select a key from the ethereum keyring
+const FlagEthKey = "eth-key"
+
+// FlagReceiver is the flag used to pass the cosmos receiver of a quarantined deposit
+const FlagReceiver = "receiver"
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -26,9 +38,11 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand(
 		CmdSendToEthereum(),
+		CmdSendToEthereumMulti(),
 		CmdCancelSendToEthereum(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdResolveQuarantinedDeposit(),
 	)
 
 	return gravityTxCmd
@@ -38,8 +52,15 @@ func CmdSendToEthereum() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "send-to-ethereum [ethereum-reciever] [send-coins] [fee-coins]",
 		Aliases: []string{"send", "transfer"},
-		Args:    cobra.ExactArgs(3),
+		Args:    cobra.RangeArgs(2, 3),
 		Short:   "Send tokens from cosmos chain to connected ethereum chain",
+		Long: fmt.Sprintf(`Send tokens from cosmos chain to connected ethereum chain.
+
+The bridge fee is given as the fee-coins argument or with --%[1]s. With --%[1]s=%[2]s the
+fee is estimated from the fees of the transfers in the unbatched pool for the token,
+picked at --%[3]s and raised if needed so the transfer lands in the next batch.
+With --%[4]s the transfer is followed through batching until it is executed on
+ethereum or cancelled.`, FlagBridgeFee, bridgeFeeAuto, FlagFeePercentile, FlagWait),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -61,8 +82,24 @@ func CmdSendToEthereum() *cobra.Command {
 				return err
 			}
 
-			feeCoin, err := sdk.ParseCoinNormalized(args[2])
-			if err != nil {
+			fee, _ := cmd.Flags().GetString(FlagBridgeFee)
+			switch {
+			case len(args) == 3 && fee != "":
+				return fmt.Errorf("cannot pass both fee-coins and --%s", FlagBridgeFee)
+			case len(args) == 3:
+				fee = args[2]
+			case fee == "":
+				return fmt.Errorf("must pass fee-coins or --%s", FlagBridgeFee)
+			}
+
+			var feeCoin sdk.Coin
+			if fee == bridgeFeeAuto {
+				percentile, _ := cmd.Flags().GetUint64(FlagFeePercentile)
+				if feeCoin, err = queryBridgeFee(cmd, clientCtx, sendCoin.Denom, percentile); err != nil {
+					return err
+				}
+				cmd.PrintErrf("estimated bridge fee: %s\n", feeCoin)
+			} else if feeCoin, err = sdk.ParseCoinNormalized(fee); err != nil {
 				return err
 			}
 
@@ -71,6 +108,68 @@ func CmdSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			if wait, _ := cmd.Flags().GetBool(FlagWait); !wait {
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			id, err := broadcastSendToEthereum(clientCtx, cmd, msg)
+			if err != nil {
+				return err
+			}
+			interval, _ := cmd.Flags().GetDuration(FlagWaitInterval)
+			return waitForSendToEthereum(cmd, clientCtx, from.String(), id, interval)
+		},
+	}
+
+	cmd.Flags().String(FlagBridgeFee, "", fmt.Sprintf("Bridge fee coin, or %s to estimate it from the unbatched pool", bridgeFeeAuto))
+	cmd.Flags().Uint64(FlagFeePercentile, 50, "Percentile of the unbatched pool fees to pick an estimated bridge fee at")
+	cmd.Flags().Bool(FlagWait, false, "Wait for the transfer to be executed on ethereum or cancelled, printing its status")
+	cmd.Flags().Duration(FlagWaitInterval, 10*time.Second, "Interval to poll the transfer status at with --"+FlagWait)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendToEthereumMulti() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-to-ethereum-multi [csv-file]",
+		Aliases: []string{"send-multi", "transfer-multi"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Send tokens to many ethereum recipients in a single transaction",
+		Long: `Send tokens to many ethereum recipients in a single transaction. The entries are
+read from a CSV file with one ethereum-reciever,send-coins,fee-coins line per transfer,
+lines starting with # are ignored. All coins must be of the same denom.`,
+		Example: fmt.Sprintf(`$ cat withdrawals.csv
+# ethereum-reciever,send-coins,fee-coins
+0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7,1000gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5,10gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5
+0x69592e6f9d21989a043646fE8225da2600e5A0f7,2500gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5,10gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5
+$ %s tx gravity send-to-ethereum-multi withdrawals.csv --from exchange`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			entries, err := readSendToEthereumEntries(f)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSendToEthereumMulti(from, entries)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -145,11 +244,15 @@ func CmdRequestBatchTx() *cobra.Command {
 func CmdSetDelegateKeys() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.RangeArgs(3, 4),
 		Short: "Set gravity delegate keys",
 		Long: `Set a validator's Ethereum and orchestrator addresses. The validator must
 sign over a binary Proto-encoded DelegateKeysSignMsg message. The message contains
-the validator's address and operator account current nonce.`,
+the validator's address and operator account current nonce.
+
+Instead of passing a pre-computed signature, --eth-key signs the message with a key
+from the ethereum keyring (see eth_keys). The validator account sequence is queried,
+or read from --sequence in offline mode.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -171,12 +274,165 @@ the validator's address and operator account current nonce.`,
 				return err
 			}
 
-			ethSig, err := hexutil.Decode(args[3])
+			var ethSig []byte
+			if ethKey, _ := cmd.Flags().GetString(FlagEthKey); ethKey != "" {
+				if len(args) == 4 {
+					return fmt.Errorf("cannot pass both an ethereum signature and --%s", FlagEthKey)
+				}
+
+				nonce, err := delegateKeysNonce(clientCtx, cmd.Flags(), valAddr)
+				if err != nil {
+					return err
+				}
+
+				if ethSig, err = SignDelegateKeysFromCmd(cmd, ethKey, common.HexToAddress(ethAddr), valAddr, nonce); err != nil {
+					return err
+				}
+			} else {
+				if len(args) != 4 {
+					return fmt.Errorf("must pass an ethereum signature or --%s", FlagEthKey)
+				}
+
+				if ethSig, err = hexutil.Decode(args[3]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagEthKey, "", "Sign the delegate keys message with this key name or address from the ethereum keyring")
+	cmd.Flags().String(FlagPassphraseFile, "", "Read the ethereum keyring passphrase from a file instead of prompting for it")
+	cmd.Flags().String(FlagEthKeyringBackend, "", "Select the ethereum keyring backend (file|test); if omitted, --keyring-backend is used")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SignDelegateKeysFromCmd signs the DelegateKeysSignMsg for the validator and
+// nonce with a key from the ethereum keyring, which must match ethAddr
+func SignDelegateKeysFromCmd(cmd *cobra.Command, ethKey string, ethAddr common.Address, valAddr sdk.ValAddress, nonce uint64) ([]byte, error) {
+	return signWithEthKeyFromCmd(cmd, ethKey, &ethAddr, &types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr.String(),
+		Nonce:            nonce,
+	})
+}
+
+// signWithEthKeyFromCmd signs the keccak hash of the Proto-encoded message with
+// a key from the ethereum keyring, which must match ethAddr if it isn't nil
+func signWithEthKeyFromCmd(cmd *cobra.Command, ethKey string, ethAddr *common.Address, signMsg codec.ProtoMarshaler) ([]byte, error) {
+	kr, err := NewEthereumKeyringFromCmd(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := kr.Get(ethKey)
+	if err != nil {
+		return nil, err
+	}
+	if ethAddr != nil && common.HexToAddress(info.Address) != *ethAddr {
+		return nil, fmt.Errorf("ethereum key %s has address %s, expected %s", info.Name, info.Address, ethAddr.Hex())
+	}
+
+	passphrase, err := kr.Passphrase("Enter the ethereum keyring passphrase:", false)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := kr.PrivateKey(info.Name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	signMsgBz, err := signMsg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), privateKey)
+}
+
+// delegateKeysNonce returns the validator account sequence the delegate keys
+// message is signed over. This is the sequence of the account before the
+// transaction carrying the message is executed.
+func delegateKeysNonce(clientCtx client.Context, fs *pflag.FlagSet, valAddr sdk.ValAddress) (uint64, error) {
+	if clientCtx.Offline {
+		return fs.GetUint64(flags.FlagSequence)
+	}
+
+	_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(valAddr))
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+func CmdResolveQuarantinedDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-quarantined-deposit [event-nonce] [ethereum-signature]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Credit or refund a quarantined deposit",
+		Long: fmt.Sprintf(`Credit a deposit which failed to credit its cosmos receiver to the
+--%[1]s account, or send it back to its ethereum sender without --%[1]s.
+The ethereum sender of the deposit must sign over a binary Proto-encoded
+DepositResolutionSignMsg message, containing the event nonce, the cosmos receiver,
+the gravity id and the bridge contract address.
+
+Instead of passing a pre-computed signature, --%[2]s signs the message with a key
+from the ethereum keyring (see eth_keys).`, FlagReceiver, FlagEthKey),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			receiver, _ := cmd.Flags().GetString(FlagReceiver)
+
+			var ethSig []byte
+			if ethKey, _ := cmd.Flags().GetString(FlagEthKey); ethKey != "" {
+				if len(args) == 2 {
+					return fmt.Errorf("cannot pass both an ethereum signature and --%s", FlagEthKey)
+				}
+
+				paramsRes, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.ParamsRequest{})
+				if err != nil {
+					return err
+				}
+
+				signMsg := &types.DepositResolutionSignMsg{
+					EventNonce:            eventNonce,
+					CosmosReceiver:        receiver,
+					GravityId:             paramsRes.Params.GravityId,
+					BridgeEthereumAddress: paramsRes.Params.BridgeEthereumAddress,
+				}
+				if ethSig, err = signWithEthKeyFromCmd(cmd, ethKey, nil, signMsg); err != nil {
+					return err
+				}
+			} else {
+				if len(args) != 2 {
+					return fmt.Errorf("must pass an ethereum signature or --%s", FlagEthKey)
+				}
+
+				if ethSig, err = hexutil.Decode(args[1]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgResolveQuarantinedDeposit(eventNonce, receiver, ethSig, from)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -185,6 +441,10 @@ the validator's address and operator account current nonce.`,
 		},
 	}
 
+	cmd.Flags().String(FlagReceiver, "", "Cosmos account to credit the deposit to, the deposit is refunded without it")
+	cmd.Flags().String(FlagEthKey, "", "Sign the deposit resolution message with this key name or address from the ethereum keyring")
+	cmd.Flags().String(FlagPassphraseFile, "", "Read the ethereum keyring passphrase from a file instead of prompting for it")
+	cmd.Flags().String(FlagEthKeyringBackend, "", "Select the ethereum keyring backend (file|test); if omitted, --keyring-backend is used")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
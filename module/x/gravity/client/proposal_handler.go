@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/client/cli"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/client/rest"
+)
+
+// ResolveQuarantinedDepositProposalHandler is the quarantined deposit resolution proposal handler
+var ResolveQuarantinedDepositProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitResolveQuarantinedDepositProposal, rest.ResolveQuarantinedDepositProposalRESTHandler)
+
+// SetERC20TokenMetadataProposalHandler is the token registry proposal handler
+var SetERC20TokenMetadataProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetERC20TokenMetadataProposal, rest.SetERC20TokenMetadataProposalRESTHandler)
+
+// ApproveCosmosERC20ProposalHandler is the cosmos originated ERC20 approval proposal handler
+var ApproveCosmosERC20ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitApproveCosmosERC20Proposal, rest.ApproveCosmosERC20ProposalRESTHandler)
@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// ResolveQuarantinedDepositProposalReq defines a quarantined deposit resolution proposal request body
+type ResolveQuarantinedDepositProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title          string         `json:"title" yaml:"title"`
+	Description    string         `json:"description" yaml:"description"`
+	EventNonce     uint64         `json:"event_nonce,string" yaml:"event_nonce"`
+	CosmosReceiver string         `json:"cosmos_receiver" yaml:"cosmos_receiver"`
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ResolveQuarantinedDepositProposalRESTHandler returns the REST handler of
+// quarantined deposit resolution proposals
+func ResolveQuarantinedDepositProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "resolve_quarantined_deposit",
+		Handler:  postResolveQuarantinedDepositProposalHandlerFn(clientCtx),
+	}
+}
+
+func postResolveQuarantinedDepositProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ResolveQuarantinedDepositProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewResolveQuarantinedDepositProposal(req.Title, req.Description, req.EventNonce, req.CosmosReceiver)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// SetERC20TokenMetadataProposalReq defines a token registry proposal request body
+type SetERC20TokenMetadataProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string         `json:"title" yaml:"title"`
+	Description   string         `json:"description" yaml:"description"`
+	TokenContract string         `json:"token_contract" yaml:"token_contract"`
+	Name          string         `json:"name" yaml:"name"`
+	Symbol        string         `json:"symbol" yaml:"symbol"`
+	Decimals      uint64         `json:"decimals,string" yaml:"decimals"`
+	Allowed       bool           `json:"allowed" yaml:"allowed"`
+	Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// SetERC20TokenMetadataProposalRESTHandler returns the REST handler of token
+// registry proposals
+func SetERC20TokenMetadataProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_erc20_token_metadata",
+		Handler:  postSetERC20TokenMetadataProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSetERC20TokenMetadataProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetERC20TokenMetadataProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetERC20TokenMetadataProposal(req.Title, req.Description, types.ERC20TokenMetadata{
+			TokenContract: req.TokenContract,
+			Name:          req.Name,
+			Symbol:        req.Symbol,
+			Decimals:      req.Decimals,
+			Allowed:       req.Allowed,
+		})
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// ApproveCosmosERC20ProposalReq defines a cosmos originated ERC20 approval
+// proposal request body
+type ApproveCosmosERC20ProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string         `json:"title" yaml:"title"`
+	Description   string         `json:"description" yaml:"description"`
+	Denom         string         `json:"denom" yaml:"denom"`
+	TokenContract string         `json:"token_contract" yaml:"token_contract"`
+	Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ApproveCosmosERC20ProposalRESTHandler returns the REST handler of cosmos
+// originated ERC20 approval proposals
+func ApproveCosmosERC20ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "approve_cosmos_erc20",
+		Handler:  postApproveCosmosERC20ProposalHandlerFn(clientCtx),
+	}
+}
+
+func postApproveCosmosERC20ProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ApproveCosmosERC20ProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewApproveCosmosERC20Proposal(req.Title, req.Description, req.Denom, req.TokenContract)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,344 @@
+// Package watch follows the typed events of the gravity module over the
+// tendermint RPC and delivers them on Go channels.
+//
+// The Watcher subscribes to new blocks over the websocket and, for every
+// height, decodes the gravity events of the block results in the order they
+// were emitted: begin blocker, successful txs, end blocker. Because events are
+// read from the block results rather than from the subscription itself, no
+// events are lost when the websocket disconnects; the Watcher resubscribes
+// and backfills every height it missed. The same mechanism backfills from a
+// start height in the past.
+//
+//	w := watch.NewWatcher(client, startHeight)
+//	batches := w.NewBatchTx()
+//	go w.Run(ctx)
+//	for batch := range batches {
+//		...
+//	}
+package watch
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	subscriber    = "gravity-watch"
+	newBlockQuery = "tm.event='NewBlockHeader'"
+	eventPrefix   = "gravity.v1."
+	channelBuffer = 16
+)
+
+// Client is the subset of the tendermint RPC client used by the Watcher. The
+// http client, which must be started to subscribe over the websocket, and the
+// local client of an in-process node both implement it.
+type Client interface {
+	rpcclient.StatusClient
+	rpcclient.EventsClient
+	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+}
+
+// Event is a gravity event emitted in the block at Height
+type Event struct {
+	Height int64
+	Event  proto.Message
+}
+
+// BatchTxCreated is a batch created in the block at Height
+type BatchTxCreated struct {
+	Height int64
+	types.EventBatchTxCreated
+}
+
+// SignerSetTxCreated is a signer set tx created in the block at Height
+type SignerSetTxCreated struct {
+	Height int64
+	types.EventSignerSetTxCreated
+}
+
+// EthereumEventObserved is an ethereum event observed in the block at Height
+type EthereumEventObserved struct {
+	Height int64
+	types.EventEthereumEventObserved
+}
+
+// SendToEthereumQueued is a send to ethereum added to the unbatched pool in
+// the block at Height
+type SendToEthereumQueued struct {
+	Height int64
+	types.EventSendToEthereum
+}
+
+// handler delivers a decoded event, it returns false if the context was done
+type handler func(ctx context.Context, height int64, event proto.Message) bool
+
+// Watcher follows the gravity events of every block. Channels are registered
+// before Run and closed when Run returns. Every registered channel must be
+// read, the Watcher waits for a slow reader rather than dropping events.
+type Watcher struct {
+	// RetryInterval is how long the Watcher waits before resubscribing after
+	// the subscription failed or was closed
+	RetryInterval time.Duration
+	// PollInterval is how often the Watcher checks for new blocks without a
+	// new block event, so it keeps up while the websocket is down
+	PollInterval time.Duration
+	Logger       log.Logger
+
+	client   Client
+	height   int64
+	handlers map[string][]handler
+	all      []handler
+	closers  []func()
+	started  bool
+}
+
+// NewWatcher returns a Watcher which starts at startHeight, or at the latest
+// block if startHeight is 0
+func NewWatcher(client Client, startHeight int64) *Watcher {
+	return &Watcher{
+		RetryInterval: 5 * time.Second,
+		PollInterval:  30 * time.Second,
+		Logger:        log.NewNopLogger(),
+		client:        client,
+		height:        startHeight - 1,
+		handlers:      make(map[string][]handler),
+	}
+}
+
+// Height returns the last height whose events were delivered. Run can be
+// resumed after it with NewWatcher(client, Height()+1).
+func (w *Watcher) Height() int64 {
+	return atomic.LoadInt64(&w.height)
+}
+
+// Events returns a channel of all gravity events
+func (w *Watcher) Events() <-chan Event {
+	ch := make(chan Event, channelBuffer)
+	w.register("", func() { close(ch) }, func(ctx context.Context, height int64, event proto.Message) bool {
+		select {
+		case ch <- Event{Height: height, Event: event}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	return ch
+}
+
+// NewBatchTx returns a channel of the created batches
+func (w *Watcher) NewBatchTx() <-chan BatchTxCreated {
+	ch := make(chan BatchTxCreated, channelBuffer)
+	w.register(proto.MessageName(&types.EventBatchTxCreated{}), func() { close(ch) }, func(ctx context.Context, height int64, event proto.Message) bool {
+		select {
+		case ch <- BatchTxCreated{height, *event.(*types.EventBatchTxCreated)}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	return ch
+}
+
+// NewSignerSetTx returns a channel of the created signer set txs
+func (w *Watcher) NewSignerSetTx() <-chan SignerSetTxCreated {
+	ch := make(chan SignerSetTxCreated, channelBuffer)
+	w.register(proto.MessageName(&types.EventSignerSetTxCreated{}), func() { close(ch) }, func(ctx context.Context, height int64, event proto.Message) bool {
+		select {
+		case ch <- SignerSetTxCreated{height, *event.(*types.EventSignerSetTxCreated)}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	return ch
+}
+
+// EventObserved returns a channel of the observed ethereum events
+func (w *Watcher) EventObserved() <-chan EthereumEventObserved {
+	ch := make(chan EthereumEventObserved, channelBuffer)
+	w.register(proto.MessageName(&types.EventEthereumEventObserved{}), func() { close(ch) }, func(ctx context.Context, height int64, event proto.Message) bool {
+		select {
+		case ch <- EthereumEventObserved{height, *event.(*types.EventEthereumEventObserved)}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	return ch
+}
+
+// SendToEthereumQueued returns a channel of the send to ethereums added to
+// the unbatched pool
+func (w *Watcher) SendToEthereumQueued() <-chan SendToEthereumQueued {
+	ch := make(chan SendToEthereumQueued, channelBuffer)
+	w.register(proto.MessageName(&types.EventSendToEthereum{}), func() { close(ch) }, func(ctx context.Context, height int64, event proto.Message) bool {
+		select {
+		case ch <- SendToEthereumQueued{height, *event.(*types.EventSendToEthereum)}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	return ch
+}
+
+func (w *Watcher) register(eventType string, closer func(), h handler) {
+	if w.started {
+		panic("watch: channels must be registered before Run")
+	}
+	if eventType == "" {
+		w.all = append(w.all, h)
+	} else {
+		w.handlers[eventType] = append(w.handlers[eventType], h)
+	}
+	w.closers = append(w.closers, closer)
+}
+
+// Run delivers the events of every block until the context is done and then
+// closes the channels. Errors of the RPC are logged and retried, Run only
+// returns the context error.
+func (w *Watcher) Run(ctx context.Context) error {
+	w.started = true
+	defer func() {
+		for _, closer := range w.closers {
+			closer()
+		}
+	}()
+
+	for {
+		blocks, err := w.client.Subscribe(ctx, subscriber, newBlockQuery)
+		if err != nil {
+			w.Logger.Error("failed to subscribe to new blocks, polling", "err", err)
+			blocks = nil
+		}
+		if err := w.watch(ctx, blocks); err != nil {
+			return err
+		}
+	}
+}
+
+// watch catches up with the chain on every new block and poll until the
+// subscription is closed, or until it is time to retry a failed subscription
+func (w *Watcher) watch(ctx context.Context, blocks <-chan ctypes.ResultEvent) error {
+	var retry <-chan time.Time
+	if blocks == nil {
+		retry = time.After(w.RetryInterval)
+	} else {
+		defer func() {
+			// the context may be done already, unsubscribe regardless
+			if err := w.client.Unsubscribe(context.Background(), subscriber, newBlockQuery); err != nil {
+				w.Logger.Debug("failed to unsubscribe from new blocks", "err", err)
+			}
+		}()
+	}
+
+	poll := time.NewTicker(w.PollInterval)
+	defer poll.Stop()
+
+	for {
+		if err := w.catchUp(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			w.Logger.Error("failed to catch up with the chain", "height", w.Height()+1, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-blocks:
+			if !ok {
+				w.Logger.Info("new block subscription closed, resubscribing")
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(w.RetryInterval):
+				}
+				return nil
+			}
+		case <-poll.C:
+		case <-retry:
+			return nil
+		}
+	}
+}
+
+// catchUp delivers the events of every height from the last delivered height
+// to the latest block
+func (w *Watcher) catchUp(ctx context.Context) error {
+	status, err := w.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+	if w.Height() < 0 {
+		atomic.StoreInt64(&w.height, latest-1)
+	}
+
+	for height := w.Height() + 1; height <= latest; height++ {
+		res, err := w.client.BlockResults(ctx, &height)
+		if err != nil {
+			return err
+		}
+		if !w.deliver(ctx, height, res) {
+			return ctx.Err()
+		}
+		atomic.StoreInt64(&w.height, height)
+	}
+	return nil
+}
+
+// deliver decodes the gravity events of the block results in the order they
+// were emitted and hands them to the handlers. Events of failed txs were
+// reverted and are skipped.
+func (w *Watcher) deliver(ctx context.Context, height int64, res *ctypes.ResultBlockResults) bool {
+	var events []abci.Event
+	events = append(events, res.BeginBlockEvents...)
+	for _, tx := range res.TxsResults {
+		if tx.IsOK() {
+			events = append(events, tx.Events...)
+		}
+	}
+	events = append(events, res.EndBlockEvents...)
+
+	for _, event := range events {
+		msg, ok := w.decode(height, event)
+		if !ok {
+			continue
+		}
+		for _, h := range w.handlers[event.Type] {
+			if !h(ctx, height, msg) {
+				return false
+			}
+		}
+		for _, h := range w.all {
+			if !h(ctx, height, msg) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (w *Watcher) decode(height int64, event abci.Event) (proto.Message, bool) {
+	if !strings.HasPrefix(event.Type, eventPrefix) {
+		return nil, false
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		w.Logger.Error("failed to decode gravity event", "height", height, "type", event.Type, "err", err)
+		return nil, false
+	}
+	return msg, true
+}
@@ -0,0 +1,178 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	rpclocal "github.com/tendermint/tendermint/rpc/client/local"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// the websocket client of a remote node and the client of an in-process node
+var (
+	_ Client = (*rpchttp.HTTP)(nil)
+	_ Client = (*rpclocal.Local)(nil)
+)
+
+// fakeClient serves block results from memory. Every subscription is handed
+// to the test, which closes it to simulate a websocket disconnect.
+type fakeClient struct {
+	mu            sync.Mutex
+	blocks        []*ctypes.ResultBlockResults
+	subscriptions chan chan ctypes.ResultEvent
+	failSubscribe bool
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{subscriptions: make(chan chan ctypes.ResultEvent, 10)}
+}
+
+func (c *fakeClient) addBlock(res *ctypes.ResultBlockResults) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res.Height = int64(len(c.blocks) + 1)
+	c.blocks = append(c.blocks, res)
+}
+
+func (c *fakeClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))}}, nil
+}
+
+func (c *fakeClient) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if *height < 1 || *height > int64(len(c.blocks)) {
+		return nil, errors.New("height not available")
+	}
+	return c.blocks[*height-1], nil
+}
+
+func (c *fakeClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failSubscribe {
+		c.failSubscribe = false
+		return nil, errors.New("websocket down")
+	}
+	sub := make(chan ctypes.ResultEvent, 1)
+	c.subscriptions <- sub
+	return sub, nil
+}
+
+func (c *fakeClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *fakeClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+func typedEvents(t *testing.T, msgs ...proto.Message) []abci.Event {
+	var events []abci.Event
+	for _, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+	return events
+}
+
+func TestWatcher(t *testing.T) {
+	client := newFakeClient()
+	client.addBlock(&ctypes.ResultBlockResults{
+		BeginBlockEvents: typedEvents(t, &types.EventSignerSetTxCreated{Nonce: 1, Height: 1}),
+	})
+	client.addBlock(&ctypes.ResultBlockResults{
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: typedEvents(t, &types.EventSendToEthereum{Id: 1, Amount: sdk.NewInt(100), BridgeFee: sdk.NewInt(1)})},
+			// reverted tx
+			{Code: 1, Events: typedEvents(t, &types.EventSendToEthereum{Id: 2, Amount: sdk.NewInt(100), BridgeFee: sdk.NewInt(1)})},
+		},
+		EndBlockEvents: append(
+			[]abci.Event{{Type: "transfer"}},
+			typedEvents(t, &types.EventEthereumEventObserved{EventNonce: 1})...,
+		),
+	})
+
+	watcher := NewWatcher(client, 2)
+	watcher.RetryInterval = 10 * time.Millisecond
+	watcher.PollInterval = time.Hour
+	events := watcher.Events()
+	batches := watcher.NewBatchTx()
+	signerSets := watcher.NewSignerSetTx()
+	queued := watcher.SendToEthereumQueued()
+	observed := watcher.EventObserved()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx) }()
+
+	// backfill from the start height, skipping block 1
+	require.Equal(t, SendToEthereumQueued{2, types.EventSendToEthereum{Id: 1, Amount: sdk.NewInt(100), BridgeFee: sdk.NewInt(1)}}, <-queued)
+	require.Equal(t, EthereumEventObserved{2, types.EventEthereumEventObserved{EventNonce: 1}}, <-observed)
+	require.Equal(t, int64(2), (<-events).Height)
+	require.Equal(t, int64(2), (<-events).Height)
+
+	// a new block event triggers the next height
+	sub := <-client.subscriptions
+	client.addBlock(&ctypes.ResultBlockResults{
+		EndBlockEvents: typedEvents(t, &types.EventBatchTxCreated{BatchNonce: 1, SendToEthereumIds: []uint64{7}, Fees: sdk.NewInt(5)}),
+	})
+	sub <- ctypes.ResultEvent{}
+	require.Equal(t, BatchTxCreated{3, types.EventBatchTxCreated{BatchNonce: 1, SendToEthereumIds: []uint64{7}, Fees: sdk.NewInt(5)}}, <-batches)
+	require.Equal(t, int64(3), (<-events).Height)
+
+	// blocks produced while disconnected are backfilled after resubscribing,
+	// polling while the subscription fails
+	client.mu.Lock()
+	client.failSubscribe = true
+	client.mu.Unlock()
+	close(sub)
+	client.addBlock(&ctypes.ResultBlockResults{
+		BeginBlockEvents: typedEvents(t, &types.EventSignerSetTxCreated{Nonce: 2, Height: 4}),
+	})
+	client.addBlock(&ctypes.ResultBlockResults{
+		BeginBlockEvents: typedEvents(t, &types.EventSignerSetTxCreated{Nonce: 3, Height: 5}),
+	})
+	for _, nonce := range []uint64{2, 3} {
+		signerSet := <-signerSets
+		require.Equal(t, nonce, signerSet.Nonce)
+		require.Equal(t, int64(signerSet.EventSignerSetTxCreated.Height), signerSet.Height)
+	}
+	require.Equal(t, int64(4), (<-events).Height)
+	require.Equal(t, int64(5), (<-events).Height)
+	<-client.subscriptions
+	require.Eventually(t, func() bool { return watcher.Height() == 5 }, time.Second, time.Millisecond)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+	_, ok := <-batches
+	require.False(t, ok)
+}
+
+func TestWatcherStartsAtLatestBlock(t *testing.T) {
+	client := newFakeClient()
+	client.addBlock(&ctypes.ResultBlockResults{
+		EndBlockEvents: typedEvents(t, &types.EventBatchTxCreated{BatchNonce: 1, Fees: sdk.NewInt(1)}),
+	})
+	client.addBlock(&ctypes.ResultBlockResults{
+		EndBlockEvents: typedEvents(t, &types.EventBatchTxCreated{BatchNonce: 2, Fees: sdk.NewInt(1)}),
+	})
+
+	watcher := NewWatcher(client, 0)
+	batches := watcher.NewBatchTx()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.Run(ctx)
+
+	require.Equal(t, uint64(2), (<-batches).BatchNonce)
+}
@@ -71,6 +71,7 @@ func addDenomToERC20Relation(tv *testingVars) {
 		Base:    "uatom",
 		Display: "atom",
 	})
+	require.NoError(tv.t, tv.input.GravityKeeper.ApproveCosmosERC20(tv.ctx, tv.denom, tv.erc20))
 
 	var myNonce = uint64(1)
 
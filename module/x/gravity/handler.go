@@ -20,6 +20,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSendToEthereumMulti:
+			res, err := msgServer.SendToEthereumMulti(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgCancelSendToEthereum:
 			res, err := msgServer.CancelSendToEthereum(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -36,10 +40,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitEthereumTxConfirmations:
+			res, err := msgServer.SubmitEthereumTxConfirmations(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitEthereumEvents:
+			res, err := msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDelegateKeys:
 			res, err := msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgResolveQuarantinedDeposit:
+			res, err := msgServer.ResolveQuarantinedDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// isOrchestratorMsg returns true for the messages orchestrators submit for
+// their validator every block
+func isOrchestratorMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *types.MsgSubmitEthereumEvent, *types.MsgSubmitEthereumTxConfirmation,
+		*types.MsgSubmitEthereumEvents, *types.MsgSubmitEthereumTxConfirmations:
+		return true
+	default:
+		return false
+	}
+}
+
+// isOrchestratorTx returns true if the tx has orchestrator messages. It
+// returns an error if it mixes them with other messages.
+func isOrchestratorTx(tx sdk.Tx) (bool, error) {
+	msgs := tx.GetMsgs()
+	orchestratorMsgs := 0
+	for _, msg := range msgs {
+		if isOrchestratorMsg(msg) {
+			orchestratorMsgs++
+		}
+	}
+	if orchestratorMsgs > 0 && orchestratorMsgs != len(msgs) {
+		return false, sdkerrors.Wrap(types.ErrInvalid, "orchestrator messages can't be mixed with other messages")
+	}
+	return orchestratorMsgs > 0, nil
+}
+
+// OrchestratorMsgDecorator rejects txs mixing orchestrator messages with other
+// messages. At CheckTx it also rejects event votes which are not the next
+// event of the validator and confirmations the validator already submitted,
+// so they never reach the mempool. An orchestrator therefore submits all the
+// events it observed in one tx per block.
+type OrchestratorMsgDecorator struct {
+	k Keeper
+}
+
+// NewOrchestratorMsgDecorator returns a new OrchestratorMsgDecorator
+func NewOrchestratorMsgDecorator(k Keeper) OrchestratorMsgDecorator {
+	return OrchestratorMsgDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d OrchestratorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ok, err := isOrchestratorTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	checker := orchestratorMsgChecker{
+		k:             d.k,
+		eventNonces:   make(map[string]uint64),
+		confirmations: make(map[string]bool),
+	}
+	for _, msg := range tx.GetMsgs() {
+		var err error
+		switch msg := msg.(type) {
+		case *types.MsgSubmitEthereumEvent:
+			err = checker.checkEvents(ctx, msg.Signer, msg.Event)
+		case *types.MsgSubmitEthereumEvents:
+			err = checker.checkEvents(ctx, msg.Signer, msg.Events...)
+		case *types.MsgSubmitEthereumTxConfirmation:
+			err = checker.checkConfirmations(ctx, msg.Signer, msg.Confirmation)
+		case *types.MsgSubmitEthereumTxConfirmations:
+			err = checker.checkConfirmations(ctx, msg.Signer, msg.Confirmations...)
+		}
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// OrchestratorFeeDecorator exempts txs of orchestrator messages signed for
+// bonded validators from the minimum gas prices of the node, so orchestrators
+// don't need funded accounts. It must run before the MempoolFeeDecorator and
+// after the OrchestratorMsgDecorator, which rejects txs mixing orchestrator
+// messages with others.
+type OrchestratorFeeDecorator struct {
+	k Keeper
+}
+
+// NewOrchestratorFeeDecorator returns a new OrchestratorFeeDecorator
+func NewOrchestratorFeeDecorator(k Keeper) OrchestratorFeeDecorator {
+	return OrchestratorFeeDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d OrchestratorFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ok, err := isOrchestratorTx(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if _, err := d.k.getSignerValidator(ctx, signer.String()); err != nil {
+				return next(ctx, tx, simulate)
+			}
+		}
+	}
+
+	// the node's minimum gas prices only apply to this tx
+	newCtx, err := next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+	return newCtx.WithMinGasPrices(ctx.MinGasPrices()), err
+}
+
+// orchestratorMsgChecker checks the events and confirmations of a tx against
+// the state and the ones before them in the tx
+type orchestratorMsgChecker struct {
+	k             Keeper
+	eventNonces   map[string]uint64
+	confirmations map[string]bool
+}
+
+// checkEvents returns an error if the events are not the next events of the
+// validator of the signer
+func (c orchestratorMsgChecker) checkEvents(ctx sdk.Context, signer string, events ...*cdctypes.Any) error {
+	val, err := c.k.getSignerValidator(ctx, signer)
+	if err != nil {
+		return err
+	}
+	for _, any := range events {
+		event, err := types.UnpackEvent(any)
+		if err != nil {
+			return err
+		}
+		// later events of the validator in the same tx follow the first
+		lastNonce, ok := c.eventNonces[val.String()]
+		if !ok {
+			lastNonce = c.k.getLastEventNonceByValidator(ctx, val)
+		}
+		if event.GetEventNonce() != lastNonce+1 {
+			return sdkerrors.Wrapf(types.ErrInvalid,
+				"non contiguous event nonce expected %v observed %v for validator %v",
+				lastNonce+1,
+				event.GetEventNonce(),
+				val,
+			)
+		}
+		c.eventNonces[val.String()] = event.GetEventNonce()
+	}
+	return nil
+}
+
+// checkConfirmations returns an error if the validator of the signer already
+// submitted one of the confirmations
+func (c orchestratorMsgChecker) checkConfirmations(ctx sdk.Context, signer string, confirmations ...*cdctypes.Any) error {
+	val, err := c.k.getSignerValidator(ctx, signer)
+	if err != nil {
+		return err
+	}
+	for _, any := range confirmations {
+		confirmation, err := types.UnpackConfirmation(any)
+		if err != nil {
+			return err
+		}
+		key := string(types.MakeEthereumSignatureKey(confirmation.GetStoreIndex(), val))
+		if c.confirmations[key] || c.k.getEthereumSignature(ctx, confirmation.GetStoreIndex(), val) != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature duplicate for validator %s", val))
+		}
+		c.confirmations[key] = true
+	}
+	return nil
+}
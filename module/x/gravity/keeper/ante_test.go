@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// feeTx is a tx without fees
+type feeTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg                        { return tx.msgs }
+func (tx feeTx) ValidateBasic() error                      { return nil }
+func (tx feeTx) GetGas() uint64                            { return 200000 }
+func (tx feeTx) GetFee() sdk.Coins                         { return nil }
+func (tx feeTx) FeePayer() sdk.AccAddress                  { return tx.msgs[0].GetSigners()[0] }
+func (tx feeTx) FeeGranter() sdk.AccAddress                { return nil }
+func (tx feeTx) GetMemo() string                           { return "" }
+func (tx feeTx) GetTimeoutHeight() uint64                  { return 0 }
+func (tx feeTx) GetExtensionOptions() []sdk.Msg            { return nil }
+func (tx feeTx) GetNonCriticalExtensionOptions() []sdk.Msg { return nil }
+
+func eventMsg(t *testing.T, nonce uint64, signer sdk.AccAddress) *types.MsgSubmitEthereumEvent {
+	any, err := types.PackEvent(&types.SendToCosmosEvent{
+		EventNonce:     nonce,
+		TokenContract:  EthAddrs[0].Hex(),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+		Amount:         sdk.NewInt(1),
+	})
+	require.NoError(t, err)
+	return &types.MsgSubmitEthereumEvent{Event: any, Signer: signer.String()}
+}
+
+func TestOrchestratorMsgDecorator(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	anteHandler := sdk.ChainAnteDecorators(NewOrchestratorMsgDecorator(k))
+	checkCtx := ctx.WithIsCheckTx(true)
+
+	signerSet := k.CreateSignerSetTx(ctx)
+	confirmation := &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSet.Nonce,
+		EthereumSigner: EthAddrs[0].Hex(),
+		Signature:      []byte("signature"),
+	}
+	any, err := types.PackConfirmation(confirmation)
+	require.NoError(t, err)
+	confirmationMsg := &types.MsgSubmitEthereumTxConfirmation{Confirmation: any, Signer: AccAddrs[0].String()}
+
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 3)
+
+	testCases := []struct {
+		name  string
+		msgs  []sdk.Msg
+		ctx   sdk.Context
+		valid bool
+	}{
+		{"next event", []sdk.Msg{eventMsg(t, 4, AccAddrs[0])}, checkCtx, true},
+		{"contiguous events", []sdk.Msg{eventMsg(t, 4, AccAddrs[0]), eventMsg(t, 5, AccAddrs[0]), eventMsg(t, 1, AccAddrs[1])}, checkCtx, true},
+		{"old event", []sdk.Msg{eventMsg(t, 3, AccAddrs[0])}, checkCtx, false},
+		{"future event", []sdk.Msg{eventMsg(t, 5, AccAddrs[0])}, checkCtx, false},
+		{"repeated event", []sdk.Msg{eventMsg(t, 4, AccAddrs[0]), eventMsg(t, 4, AccAddrs[0])}, checkCtx, false},
+		{"not a validator", []sdk.Msg{eventMsg(t, 1, sdk.AccAddress("not a validator"))}, checkCtx, false},
+		{"old event at deliver", []sdk.Msg{eventMsg(t, 3, AccAddrs[0])}, ctx, true},
+		{"batched events", []sdk.Msg{&types.MsgSubmitEthereumEvents{Events: []*cdctypes.Any{eventMsg(t, 4, AccAddrs[0]).Event, eventMsg(t, 5, AccAddrs[0]).Event}, Signer: AccAddrs[0].String()}}, checkCtx, true},
+		{"batched future events", []sdk.Msg{&types.MsgSubmitEthereumEvents{Events: []*cdctypes.Any{eventMsg(t, 5, AccAddrs[0]).Event}, Signer: AccAddrs[0].String()}}, checkCtx, false},
+		{"confirmation", []sdk.Msg{confirmationMsg}, checkCtx, true},
+		{"batched repeated confirmation", []sdk.Msg{confirmationMsg, &types.MsgSubmitEthereumTxConfirmations{Confirmations: []*cdctypes.Any{any}, Signer: AccAddrs[0].String()}}, checkCtx, false},
+		{"repeated confirmation", []sdk.Msg{confirmationMsg, confirmationMsg}, checkCtx, false},
+		{"mixed", []sdk.Msg{eventMsg(t, 4, AccAddrs[0]), &types.MsgRequestBatchTx{Denom: "stake", Signer: AccAddrs[0].String()}}, checkCtx, false},
+		{"mixed at deliver", []sdk.Msg{eventMsg(t, 4, AccAddrs[0]), &types.MsgRequestBatchTx{Denom: "stake", Signer: AccAddrs[0].String()}}, ctx, false},
+		{"other", []sdk.Msg{&types.MsgRequestBatchTx{Denom: "stake", Signer: AccAddrs[0].String()}}, checkCtx, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler(tc.ctx, feeTx{msgs: tc.msgs}, false)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	k.SetEthereumSignature(ctx, confirmation, ValAddrs[0])
+	_, err = anteHandler(checkCtx, feeTx{msgs: []sdk.Msg{confirmationMsg}}, false)
+	require.Error(t, err)
+}
+
+func TestOrchestratorFeeDecorator(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	anteHandler := sdk.ChainAnteDecorators(NewOrchestratorFeeDecorator(k), ante.NewMempoolFeeDecorator())
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))))
+
+	// orchestrator messages of bonded validators are free
+	_, err := anteHandler(checkCtx, feeTx{msgs: []sdk.Msg{eventMsg(t, 1, AccAddrs[0])}}, false)
+	require.NoError(t, err)
+
+	// others pay the minimum gas prices
+	_, err = anteHandler(checkCtx, feeTx{msgs: []sdk.Msg{eventMsg(t, 1, sdk.AccAddress("not a validator"))}}, false)
+	require.Error(t, err)
+	_, err = anteHandler(checkCtx, feeTx{msgs: []sdk.Msg{&types.MsgRequestBatchTx{Denom: "stake", Signer: AccAddrs[0].String()}}}, false)
+	require.Error(t, err)
+}
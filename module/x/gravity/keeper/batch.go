@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
@@ -16,35 +18,68 @@ const BatchTxSize = 100
 
 // BuildBatchTx starts the following process chain:
 // - find bridged denominator for given voucher type
+// - select the refunds of quarantined deposits, up to half of the batch, then available transactions from the
+//   outgoing transaction pool sorted by fee desc
 // - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit withtout creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - if the batch pays no fees, e.g. it only refunds deposits, confirm no other batch paying no fees is pending,
+//   so they are signed one at a time. If one is exit without creating a batch
+// - for balance delta accounting tokens, confirm the batch together with all pending batches
+//   does not exceed the escrow balance of the bridge contract. If it does exit without creating a batch
+// - run the BeforeBatchTxCreated hooks, if one vetoes the batch exit without creating it
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	selectedStes := k.selectBatchSendToEthereums(ctx, contractAddress, maxElements)
+
 	// if there is a more profitable batch for this token type do not create a new batch
 	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
-		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
+		if lastBatch.GetFees().GTE(sendToEthereumFees(selectedStes)) {
 			return nil
 		}
 	}
-
-	var selectedStes []*types.SendToEthereum
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
-		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
-		return len(selectedStes) == maxElements
-	})
+	if sendToEthereumFees(selectedStes).IsZero() && k.hasPendingFeelessBatchTx(ctx) {
+		return nil
+	}
 
 	batch := &types.BatchTx{
-		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
-		Timeout:       k.getBatchTimeoutHeight(ctx),
 		Transactions:  selectedStes,
 		TokenContract: contractAddress.Hex(),
 		Height:        uint64(ctx.BlockHeight()),
 	}
+
+	// refuse batches which the bridge contract could not pay out
+	if k.isBalanceDeltaAccountingToken(ctx, contractAddress) {
+		pending := k.getPendingBatchTotalByTokenType(ctx, contractAddress)
+		if pending.Add(batch.GetTotal()).GT(k.GetERC20EscrowBalance(ctx, contractAddress)) {
+			return nil
+		}
+	}
+
+	batch.BatchNonce = k.getLastOutgoingBatchNonce(ctx) + 1
+	batch.Timeout = k.getBatchTimeoutHeight(ctx)
+	if err := k.BeforeBatchTxCreated(ctx, *batch); err != nil {
+		k.Logger(ctx).Info("batch tx vetoed", "token_contract", batch.TokenContract, "cause", err.Error())
+		return nil
+	}
+
+	for _, ste := range selectedStes {
+		k.deleteUnbatchedSendToEthereum(ctx, ste)
+	}
+	k.incrementLastOutgoingBatchNonce(ctx)
 	k.SetOutgoingTx(ctx, batch)
 
+	event := &types.EventBatchTxCreated{
+		TokenContract: batch.TokenContract,
+		BatchNonce:    batch.BatchNonce,
+		Timeout:       batch.Timeout,
+		Fees:          sdk.ZeroInt(),
+	}
+	for _, ste := range selectedStes {
+		event.SendToEthereumIds = append(event.SendToEthereumIds, ste.Id)
+		event.Fees = event.Fees.Add(ste.Erc20Fee.Amount)
+	}
+	k.emitTypedEvent(ctx, event)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeOutgoingBatch,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -53,6 +88,7 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
 	))
+	k.AfterBatchTxCreated(ctx, *batch)
 
 	return batch
 }
@@ -60,23 +96,33 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 // This gets the batch timeout height in Ethereum blocks.
 func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	params := k.GetParams(ctx)
-	currentCosmosHeight := ctx.BlockHeight()
-	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
-	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
-	heights := k.GetLastObservedEthereumBlockHeight(ctx)
-	if heights.CosmosHeight == 0 || heights.EthereumHeight == 0 {
+	// we do not concern ourselves if the projection is zero because no batch can be produced if the last
+	// Ethereum block height is not first populated by a deposit event.
+	projectedCurrentEthereumHeight := k.getProjectedEthereumHeight(ctx)
+	if projectedCurrentEthereumHeight == 0 {
 		return 0
 	}
-	// we project how long it has been in milliseconds since the last Ethereum block height was observed
-	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosHeight) * params.AverageBlockTime
-	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumHeight
 	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
 	// place on top of our projection of the current Ethereum block height.
 	blocksToAdd := params.TargetEthTxTimeout / params.AverageEthereumBlockTime
 	return projectedCurrentEthereumHeight + blocksToAdd
 }
 
+// getProjectedEthereumHeight projects the current Ethereum height from the last observed Cosmos and Ethereum
+// heights, it returns zero if no Ethereum height was observed yet. The Cosmos height is zero for heights
+// observed before a zero height restart.
+func (k Keeper) getProjectedEthereumHeight(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	heights := k.GetLastObservedEthereumBlockHeight(ctx)
+	if heights.EthereumHeight == 0 {
+		return 0
+	}
+	// we project how long it has been in milliseconds since the last Ethereum block height was observed
+	projectedMillis := (uint64(ctx.BlockHeight()) - heights.CosmosHeight) * params.AverageBlockTime
+	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
+	return (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumHeight
+}
+
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It deletes all the transactions in the batch, then cancels all earlier batches
 func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
@@ -91,22 +137,62 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		return false
 	})
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
+
+	k.emitTypedEvent(ctx, &types.EventBatchTxExecuted{
+		TokenContract: batchTx.TokenContract,
+		BatchNonce:    batchTx.BatchNonce,
+	})
 }
 
-// getBatchFeesByTokenType gets the fees the next batch of a given token type would
-// have if created. This info is both presented to relayers for the purpose of determining
-// when to request batches and also used by the batch creation process to decide not to create
-// a new batch
-func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
-	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
-		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
+// selectBatchSendToEthereums returns the sends to ethereum the next batch of a
+// token would take from the pool. Refunds of deposits pay no bridge fee, so
+// they are selected first so they don't wait behind every fee paying send to
+// ethereum, but only up to half of the batch so they don't crowd out the sends
+// paying the relayers.
+func (k Keeper) selectBatchSendToEthereums(ctx sdk.Context, tokenContract common.Address, maxElements int) []*types.SendToEthereum {
+	maxRefunds := (maxElements + 1) / 2
+
+	var selected []*types.SendToEthereum
+	refunds := make(map[uint64]bool)
+	k.iterateUnbatchedSendToEthereumsBySender(ctx, authtypes.NewModuleAddress(types.ModuleName), func(ste *types.SendToEthereum) bool {
+		if len(selected) == maxRefunds {
+			return true
+		}
+		if common.HexToAddress(ste.Erc20Token.Contract) == tokenContract {
+			selected = append(selected, ste)
+			refunds[ste.Id] = true
+		}
+		return false
 	})
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+		if len(selected) == maxElements {
+			return true
+		}
+		if !refunds[ste.Id] {
+			selected = append(selected, ste)
+		}
+		return false
+	})
+	return selected
+}
 
-	return feeAmount
+// sendToEthereumFees returns the total bridge fee of sends to ethereum
+func sendToEthereumFees(stes []*types.SendToEthereum) sdk.Int {
+	fees := sdk.ZeroInt()
+	for _, ste := range stes {
+		fees = fees.Add(ste.Erc20Fee.Amount)
+	}
+	return fees
+}
+
+// hasPendingFeelessBatchTx returns whether a batch paying no fees is pending
+func (k Keeper) hasPendingFeelessBatchTx(ctx sdk.Context) (found bool) {
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.BatchTx)
+		found = sendToEthereumFees(btx.Transactions).IsZero()
+		return found
+	})
+	return found
 }
 
 // GetBatchFeesByTokenType gets the fees the next batch of a given token type would
@@ -114,14 +200,7 @@ func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
 func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
-	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
-		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
-	})
-	return feeAmount
+	return sendToEthereumFees(k.selectBatchSendToEthereums(ctx, tokenContractAddr, maxElements))
 }
 
 // CancelBatchTx releases all TX in the batch and deletes the batch
@@ -131,12 +210,16 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, tokenContract common.Address, non
 
 	// free transactions from batch and reindex them
 	for _, tx := range batch.Transactions {
-		k.setUnbatchedSendToEthereum(ctx, tx)
+		k.releaseSendToEthereum(ctx, tx)
 	}
 
 	// Delete batch since it is finished
 	k.DeleteOutgoingTx(ctx, batch.GetStoreIndex())
 
+	k.emitTypedEvent(ctx, &types.EventBatchTxCanceled{
+		TokenContract: batch.TokenContract,
+		BatchNonce:    batch.BatchNonce,
+	})
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeOutgoingBatchCanceled,
@@ -151,17 +234,48 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, tokenContract common.Address, non
 
 // getLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, token common.Address) *types.BatchTx {
-	var lastBatch *types.BatchTx = nil
-	lastNonce := uint64(0)
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		btx, _ := otx.(*types.BatchTx)
-		if common.HexToAddress(btx.TokenContract) == token && btx.BatchNonce > lastNonce {
-			lastBatch = btx
-			lastNonce = btx.BatchNonce
-		}
-		return false
-	})
-	return lastBatch
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeLastOutgoingBatchNonceByTokenKey(token))
+	if bz == nil {
+		return nil
+	}
+	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(token, binary.BigEndian.Uint64(bz)))
+	batchTx, _ := otx.(*types.BatchTx)
+	return batchTx
+}
+
+// indexBatchTx updates the latest batch nonce of the token if the batch is newer
+func (k Keeper) indexBatchTx(ctx sdk.Context, token common.Address, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeLastOutgoingBatchNonceByTokenKey(token)
+	if bz := store.Get(key); bz == nil || binary.BigEndian.Uint64(bz) < nonce {
+		store.Set(key, sdk.Uint64ToBigEndian(nonce))
+	}
+}
+
+// unindexBatchTx falls back to the previous batch of the token if the deleted
+// batch was its latest, batches are keyed by token and nonce so it is the
+// first one in reverse order
+func (k Keeper) unindexBatchTx(ctx sdk.Context, storeIndex []byte) {
+	tokenLen := common.AddressLength
+	if len(storeIndex) != 1+tokenLen+8 {
+		return
+	}
+	token := common.BytesToAddress(storeIndex[1 : 1+tokenLen])
+	nonce := binary.BigEndian.Uint64(storeIndex[1+tokenLen:])
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeLastOutgoingBatchNonceByTokenKey(token)
+	if bz := store.Get(key); bz == nil || binary.BigEndian.Uint64(bz) != nonce {
+		return
+	}
+
+	iter := prefix.NewStore(store, types.MakeOutgoingTxKey(storeIndex[:1+tokenLen])).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		store.Set(key, iter.Key())
+	} else {
+		store.Delete(key)
+	}
 }
 
 // SetLastSlashedOutgoingTxBlockHeight sets the latest slashed Batch block height
@@ -190,14 +304,20 @@ func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out
 }
 
 func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	k.setLastOutgoingBatchNonce(ctx, newId)
 	return newId
 }
+
+func (k Keeper) setLastOutgoingBatchNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
+// getLastOutgoingBatchNonce returns the nonce of the last batch created
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastOutgoingBatchNonceKey})
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
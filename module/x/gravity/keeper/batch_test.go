@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -121,6 +123,92 @@ func TestBatches(t *testing.T) {
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 }
 
+// tests that the pool and batch state transitions emit their typed events, and
+// the legacy string events alongside them
+func TestBatchTxEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin(),
+		)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	require.Equal(t, []proto.Message{
+		&types.EventSendToEthereum{
+			Id:                1,
+			Sender:            mySender.String(),
+			EthereumRecipient: myReceiver.Hex(),
+			TokenContract:     myTokenContractAddr.Hex(),
+			Amount:            sdk.NewInt(100),
+			BridgeFee:         sdk.NewInt(2),
+		},
+		&types.EventSendToEthereum{
+			Id:                2,
+			Sender:            mySender.String(),
+			EthereumRecipient: myReceiver.Hex(),
+			TokenContract:     myTokenContractAddr.Hex(),
+			Amount:            sdk.NewInt(101),
+			BridgeFee:         sdk.NewInt(3),
+		},
+	}, typedEvents(t, ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, BatchTxSize)
+	require.NotNil(t, batch)
+	require.Equal(t, []proto.Message{
+		&types.EventBatchTxCreated{
+			TokenContract:     myTokenContractAddr.Hex(),
+			BatchNonce:        batch.BatchNonce,
+			Timeout:           batch.Timeout,
+			SendToEthereumIds: []uint64{2, 1},
+			Fees:              sdk.NewInt(5),
+		},
+	}, typedEvents(t, ctx))
+	require.Contains(t, eventTypes(ctx), types.EventTypeOutgoingBatch)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	input.GravityKeeper.CancelBatchTx(ctx, myTokenContractAddr, batch.BatchNonce)
+	require.Equal(t, []proto.Message{
+		&types.EventBatchTxCanceled{
+			TokenContract: myTokenContractAddr.Hex(),
+			BatchNonce:    batch.BatchNonce,
+		},
+	}, typedEvents(t, ctx))
+	require.Contains(t, eventTypes(ctx), types.EventTypeOutgoingBatchCanceled)
+}
+
+// typedEvents parses the gravity typed events emitted in the context
+func typedEvents(t *testing.T, ctx sdk.Context) []proto.Message {
+	var events []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, msg)
+	}
+	return events
+}
+
+// eventTypes returns the types of all events emitted in the context
+func eventTypes(ctx sdk.Context) []string {
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	return eventTypes
+}
+
 // tests that batches work with large token amounts, mostly a duplicate of the above
 // tests but using much bigger numbers
 func TestBatchesFullCoins(t *testing.T) {
@@ -354,3 +442,58 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestLastOutgoingBatchIndex(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin()}
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	require.Nil(t, k.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1)
+	first := k.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, first)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 5)
+	second := k.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, second)
+	require.Equal(t, second, k.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+
+	// deleting the latest batch falls back to the previous one
+	k.CancelBatchTx(ctx, myTokenContractAddr, second.BatchNonce)
+	require.Equal(t, first, k.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+
+	k.CancelBatchTx(ctx, myTokenContractAddr, first.BatchNonce)
+	require.Nil(t, k.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+}
+
+func BenchmarkGetLastOutgoingBatchByTokenType(b *testing.B) {
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	k := input.GravityKeeper
+	stes := fillPool(input, benchmarkPoolSize)
+
+	// a batch per hundred pool entries spread over a hundred tokens
+	var token common.Address
+	for i := 0; i < len(stes); i += 100 {
+		token = common.BigToAddress(big.NewInt(int64(i%10000 + 1)))
+		k.SetOutgoingTx(ctx, &types.BatchTx{
+			BatchNonce:    uint64(i + 1),
+			TokenContract: token.Hex(),
+			Transactions:  stes[i : i+100],
+		})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NotNil(b, k.getLastOutgoingBatchByTokenType(ctx, token))
+	}
+}
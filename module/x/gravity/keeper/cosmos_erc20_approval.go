@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// GetCosmosERC20Approval returns the approved ERC20 representation of a cosmos
+// originated denom which hasn't been deployed yet, or nil if there is none
+func (k Keeper) GetCosmosERC20Approval(ctx sdk.Context, denom string) *types.CosmosERC20Approval {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeCosmosERC20ApprovalKey(denom))
+	if bz == nil {
+		return nil
+	}
+	var approval types.CosmosERC20Approval
+	k.cdc.MustUnmarshal(bz, &approval)
+	return &approval
+}
+
+func (k Keeper) setCosmosERC20Approval(ctx sdk.Context, approval *types.CosmosERC20Approval) {
+	ctx.KVStore(k.storeKey).Set(types.MakeCosmosERC20ApprovalKey(approval.Denom), k.cdc.MustMarshal(approval))
+}
+
+func (k Keeper) deleteCosmosERC20Approval(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeCosmosERC20ApprovalKey(denom))
+}
+
+// IterateCosmosERC20Approvals iterates through the approvals by denom
+func (k Keeper) IterateCosmosERC20Approvals(ctx sdk.Context, cb func(*types.CosmosERC20Approval) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.CosmosERC20ApprovalKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var approval types.CosmosERC20Approval
+		k.cdc.MustUnmarshal(iter.Value(), &approval)
+		if cb(&approval) {
+			break
+		}
+	}
+}
+
+// GetPendingERC20Deployment returns the observed deployment of a token
+// contract which isn't approved, or nil if there is none
+func (k Keeper) GetPendingERC20Deployment(ctx sdk.Context, tokenContract common.Address) *types.ERC20DeployedEvent {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakePendingERC20DeploymentKey(tokenContract))
+	if bz == nil {
+		return nil
+	}
+	var event types.ERC20DeployedEvent
+	k.cdc.MustUnmarshal(bz, &event)
+	return &event
+}
+
+func (k Keeper) setPendingERC20Deployment(ctx sdk.Context, event *types.ERC20DeployedEvent) {
+	store := ctx.KVStore(k.storeKey)
+	tokenContract := common.HexToAddress(event.TokenContract)
+	store.Set(types.MakePendingERC20DeploymentKey(tokenContract), k.cdc.MustMarshal(event))
+	store.Set(types.MakePendingERC20DeploymentDenomKey(event.CosmosDenom, event.EventNonce, tokenContract), []byte{})
+}
+
+func (k Keeper) deletePendingERC20Deployment(ctx sdk.Context, tokenContract common.Address) {
+	deployment := k.GetPendingERC20Deployment(ctx, tokenContract)
+	if deployment == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakePendingERC20DeploymentKey(tokenContract))
+	store.Delete(types.MakePendingERC20DeploymentDenomKey(deployment.CosmosDenom, deployment.EventNonce, tokenContract))
+}
+
+// prunePendingERC20Deployments deletes the oldest pending deployments of a
+// denom beyond MaxPendingERC20DeploymentsPerDenom. Anyone can deploy an ERC20
+// token for a denom, so the pending deployments can't grow without bound.
+func (k Keeper) prunePendingERC20Deployments(ctx sdk.Context, denom string) {
+	prefixKey := append([]byte{types.PendingERC20DeploymentDenomKey}, address.MustLengthPrefix([]byte(denom))...)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).ReverseIterator(nil, nil)
+
+	var pruned []common.Address
+	for kept := 0; iter.Valid(); iter.Next() {
+		if kept < types.MaxPendingERC20DeploymentsPerDenom {
+			kept++
+			continue
+		}
+		// the keys end with the token contract after the event nonce
+		pruned = append(pruned, common.BytesToAddress(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, tokenContract := range pruned {
+		k.deletePendingERC20Deployment(ctx, tokenContract)
+	}
+}
+
+// IteratePendingERC20Deployments iterates through the pending deployments by
+// token contract
+func (k Keeper) IteratePendingERC20Deployments(ctx sdk.Context, cb func(*types.ERC20DeployedEvent) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingERC20DeploymentKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.ERC20DeployedEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(&event) {
+			break
+		}
+	}
+}
+
+// ApproveCosmosERC20 approves a token contract as the ERC20 representation of
+// a cosmos originated denom. An observed deployment of the token contract is
+// mapped right away, otherwise the approval waits for the deployment.
+func (k Keeper) ApproveCosmosERC20(ctx sdk.Context, denom, tokenContract string) error {
+	approval := types.CosmosERC20Approval{Denom: denom, TokenContract: tokenContract}
+	if err := approval.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := types.GravityDenomToERC20(denom); err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "denom %s is an ethereum originated voucher", denom)
+	}
+	contract := common.HexToAddress(tokenContract)
+	approval.TokenContract = contract.Hex()
+	if existing, exists := k.getCosmosOriginatedDenom(ctx, approval.TokenContract); exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s already represents the denom %s", approval.TokenContract, existing)
+	}
+	if k.GetERC20TokenMetadata(ctx, contract) != nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s is an ethereum originated token", approval.TokenContract)
+	}
+	// vouchers were minted for deposits of the token contract, so it was
+	// bridged as an ethereum originated token
+	if vouchers := k.bankKeeper.GetSupply(ctx, types.NewERC20Token(0, approval.TokenContract).GravityCoin().Denom); !vouchers.IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s has %s vouchers in supply", approval.TokenContract, vouchers)
+	}
+
+	if deployment := k.GetPendingERC20Deployment(ctx, contract); deployment != nil {
+		if deployment.CosmosDenom != denom {
+			return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s was deployed for the denom %s", approval.TokenContract, deployment.CosmosDenom)
+		}
+		// the denom metadata could have changed since the deployment was observed
+		if err := k.verifyERC20DeployedEvent(ctx, deployment); err != nil {
+			return err
+		}
+		k.mapCosmosERC20(ctx, denom, approval.TokenContract)
+		k.AfterERC20DeployedEvent(ctx, *deployment)
+		return nil
+	}
+
+	k.setCosmosERC20Approval(ctx, &approval)
+	k.emitTypedEvent(ctx, &types.EventCosmosERC20Approved{
+		Denom:         approval.Denom,
+		TokenContract: approval.TokenContract,
+	})
+	return nil
+}
+
+// isCosmosERC20Approved returns whether a token contract is the approved ERC20
+// representation of a denom
+func (k Keeper) isCosmosERC20Approved(ctx sdk.Context, denom string, tokenContract common.Address) bool {
+	approval := k.GetCosmosERC20Approval(ctx, denom)
+	return approval != nil && common.HexToAddress(approval.TokenContract) == tokenContract
+}
+
+// mapCosmosERC20 makes a token contract the ERC20 representation of a denom.
+// When the denom is remapped its previous token contract stays an alias of the
+// denom, so that the tokens still on ethereum can be sent back against the
+// same escrow, and the unbatched sends to ethereum are migrated to the new
+// token contract.
+func (k Keeper) mapCosmosERC20(ctx sdk.Context, denom, tokenContract string) {
+	contract := common.HexToAddress(tokenContract)
+	previous, remapped := k.getCosmosOriginatedERC20(ctx, denom)
+
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, contract.Hex())
+	k.deleteCosmosERC20Approval(ctx, denom)
+	k.deletePendingERC20Deployment(ctx, contract)
+
+	event := &types.EventCosmosERC20Mapped{
+		Denom:         denom,
+		TokenContract: contract.Hex(),
+		EscrowBalance: sdk.ZeroInt(),
+	}
+	if remapped {
+		var sends []*types.SendToEthereum
+		k.iterateUnbatchedSendToEthereumsByContract(ctx, previous, func(ste *types.SendToEthereum) bool {
+			sends = append(sends, ste)
+			return false
+		})
+		for _, ste := range sends {
+			k.migrateSendToEthereum(ctx, ste, contract)
+		}
+		event.PreviousTokenContract = previous.Hex()
+		event.EscrowBalance = k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(denom)
+	}
+	k.emitTypedEvent(ctx, event)
+}
+
+// migrateSendToEthereum moves an unbatched send to ethereum to another token
+// contract of the same denom
+func (k Keeper) migrateSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum, tokenContract common.Address) {
+	k.deleteUnbatchedSendToEthereum(ctx, ste)
+	ste.Erc20Token.Contract = tokenContract.Hex()
+	ste.Erc20Fee.Contract = tokenContract.Hex()
+	k.setUnbatchedSendToEthereum(ctx, ste)
+}
+
+// releaseSendToEthereum puts a send to ethereum back in the pool, on the
+// current token contract of its denom if its token contract was remapped
+func (k Keeper) releaseSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
+	if denom, isCosmosOriginated := k.getCosmosOriginatedDenom(ctx, ste.Erc20Token.Contract); isCosmosOriginated {
+		if current, _ := k.getCosmosOriginatedERC20(ctx, denom); current != common.HexToAddress(ste.Erc20Token.Contract) {
+			ste.Erc20Token.Contract = current.Hex()
+			ste.Erc20Fee.Contract = current.Hex()
+		}
+	}
+	k.setUnbatchedSendToEthereum(ctx, ste)
+}
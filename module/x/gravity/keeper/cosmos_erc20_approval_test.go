@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestCosmosERC20Approval(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	squatted := common.HexToAddress(TokenContractAddrs[0])
+	approved := common.HexToAddress(TokenContractAddrs[1])
+
+	deployment := func(nonce uint64, tokenContract common.Address) *types.ERC20DeployedEvent {
+		return &types.ERC20DeployedEvent{
+			EventNonce:     nonce,
+			CosmosDenom:    "stake",
+			TokenContract:  tokenContract.Hex(),
+			Erc20Name:      "stake",
+			EthereumHeight: 10,
+		}
+	}
+
+	// deployments which aren't approved wait for an approval
+	require.NoError(t, k.Handle(ctx, deployment(1, squatted)))
+	require.NoError(t, k.Handle(ctx, deployment(2, approved)))
+	_, exists := k.getCosmosOriginatedERC20(ctx, "stake")
+	require.False(t, exists)
+	res, err := k.PendingERC20Deployments(sdk.WrapSDKContext(ctx), &types.PendingERC20DeploymentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Deployments, 2)
+
+	// approving an observed deployment maps it right away
+	require.Error(t, k.ApproveCosmosERC20(ctx, "ugraviton", approved.Hex()))
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "stake", approved.Hex()))
+	tokenContract, exists := k.getCosmosOriginatedERC20(ctx, "stake")
+	require.True(t, exists)
+	require.Equal(t, approved, tokenContract)
+	require.Nil(t, k.GetPendingERC20Deployment(ctx, approved))
+	require.NotNil(t, k.GetPendingERC20Deployment(ctx, squatted))
+
+	// mapped token contracts and ethereum originated vouchers can't be approved
+	require.Error(t, k.ApproveCosmosERC20(ctx, "stake", approved.Hex()))
+	require.Error(t, k.ApproveCosmosERC20(ctx, types.NewERC20Token(0, squatted.Hex()).GravityCoin().Denom, TokenContractAddrs[2]))
+
+	// nor token contracts whose ethereum originated vouchers were minted
+	bridged := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(types.NewERC20Token(1, bridged.Hex()).GravityCoin())))
+	require.Error(t, k.ApproveCosmosERC20(ctx, "uatom", bridged.Hex()))
+
+	// a token contract can only be deployed once
+	require.Error(t, k.Handle(ctx, deployment(3, approved)))
+
+	// an approval waits for the deployment of its token contract
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "uatom", TokenContractAddrs[2]))
+	approvals, err := k.CosmosERC20Approvals(sdk.WrapSDKContext(ctx), &types.CosmosERC20ApprovalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.CosmosERC20Approval{{Denom: "uatom", TokenContract: common.HexToAddress(TokenContractAddrs[2]).Hex()}}, approvals.Approvals)
+}
+
+func TestPrunePendingERC20Deployments(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	var tokenContracts []common.Address
+	for i := 0; i <= types.MaxPendingERC20DeploymentsPerDenom; i++ {
+		tokenContract := common.BigToAddress(sdk.NewInt(int64(i + 1)).BigInt())
+		tokenContracts = append(tokenContracts, tokenContract)
+		require.NoError(t, k.Handle(ctx, &types.ERC20DeployedEvent{
+			EventNonce:     uint64(i + 1),
+			CosmosDenom:    "stake",
+			TokenContract:  tokenContract.Hex(),
+			Erc20Name:      "stake",
+			EthereumHeight: 10,
+		}))
+	}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))))
+	require.NoError(t, k.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:     100,
+		CosmosDenom:    "uatom",
+		TokenContract:  TokenContractAddrs[0],
+		Erc20Name:      "uatom",
+		EthereumHeight: 10,
+	}))
+
+	// only the oldest deployment of the denom is pruned
+	require.Nil(t, k.GetPendingERC20Deployment(ctx, tokenContracts[0]))
+	for _, tokenContract := range tokenContracts[1:] {
+		require.NotNil(t, k.GetPendingERC20Deployment(ctx, tokenContract))
+	}
+	require.NotNil(t, k.GetPendingERC20Deployment(ctx, common.HexToAddress(TokenContractAddrs[0])))
+
+	// approving a pending deployment removes it from the index
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "stake", tokenContracts[1].Hex()))
+	require.NoError(t, k.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:     101,
+		CosmosDenom:    "stake",
+		TokenContract:  common.BigToAddress(sdk.NewInt(1000).BigInt()).Hex(),
+		Erc20Name:      "stake",
+		EthereumHeight: 11,
+	}))
+	for _, tokenContract := range tokenContracts[2:] {
+		require.NotNil(t, k.GetPendingERC20Deployment(ctx, tokenContract))
+	}
+}
+
+func TestCosmosERC20Remap(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	previous := common.HexToAddress(TokenContractAddrs[0])
+	current := common.HexToAddress(TokenContractAddrs[1])
+
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "stake", previous.Hex()))
+	require.NoError(t, k.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:     1,
+		CosmosDenom:    "stake",
+		TokenContract:  previous.Hex(),
+		Erc20Name:      "stake",
+		EthereumHeight: 10,
+	}))
+	tokenContract, _ := k.getCosmosOriginatedERC20(ctx, "stake")
+	require.Equal(t, previous, tokenContract)
+
+	balance := input.BankKeeper.GetBalance(ctx, AccAddrs[0], "stake").Amount
+	_, err := k.createSendToEthereum(ctx, AccAddrs[0], EthAddrs[0].Hex(), sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, err)
+
+	// the approval of a new deployment remaps the denom once it's observed
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "stake", current.Hex()))
+	require.NoError(t, k.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:     2,
+		CosmosDenom:    "stake",
+		TokenContract:  current.Hex(),
+		Erc20Name:      "stake",
+		EthereumHeight: 11,
+	}))
+	tokenContract, _ = k.getCosmosOriginatedERC20(ctx, "stake")
+	require.Equal(t, current, tokenContract)
+	require.Nil(t, k.GetCosmosERC20Approval(ctx, "stake"))
+
+	// the unbatched sends are migrated to the new token contract
+	var sends []*types.SendToEthereum
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, current.Hex(), sends[0].Erc20Token.Contract)
+	require.Equal(t, current.Hex(), sends[0].Erc20Fee.Contract)
+	require.Equal(t, balance.SubRaw(110), input.BankKeeper.GetBalance(ctx, AccAddrs[0], "stake").Amount)
+
+	// tokens of the previous token contract still unlock the escrow
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, previous.Hex())
+	require.True(t, isCosmosOriginated)
+	require.Equal(t, "stake", denom)
+	require.NoError(t, k.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     3,
+		TokenContract:  previous.Hex(),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 12,
+		Amount:         sdk.NewInt(110),
+	}))
+	require.Equal(t, balance, input.BankKeeper.GetBalance(ctx, AccAddrs[0], "stake").Amount)
+
+	// the sends of a canceled batch of the previous token contract are
+	// released on the new one
+	batch := &types.BatchTx{
+		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
+		TokenContract: previous.Hex(),
+		Transactions: []*types.SendToEthereum{{
+			Id:                9,
+			Sender:            AccAddrs[0].String(),
+			EthereumRecipient: EthAddrs[0].Hex(),
+			Erc20Token:        types.NewSDKIntERC20Token(sdk.NewInt(5), previous),
+			Erc20Fee:          types.NewSDKIntERC20Token(sdk.NewInt(1), previous),
+		}},
+	}
+	k.SetOutgoingTx(ctx, batch)
+	k.CancelBatchTx(ctx, previous, batch.BatchNonce)
+	released := k.getUnbatchedSendToEthereum(ctx, 9)
+	require.NotNil(t, released)
+	require.Equal(t, current.Hex(), released.Erc20Token.Contract)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// GetERC20TokenMetadata returns the token registry entry of an ethereum
+// originated ERC20 token, or nil if it isn't registered
+func (k Keeper) GetERC20TokenMetadata(ctx sdk.Context, tokenContract common.Address) *types.ERC20TokenMetadata {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeERC20TokenMetadataKey(tokenContract))
+	if bz == nil {
+		return nil
+	}
+	var token types.ERC20TokenMetadata
+	k.cdc.MustUnmarshal(bz, &token)
+	return &token
+}
+
+func (k Keeper) setERC20TokenMetadata(ctx sdk.Context, token *types.ERC20TokenMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.MakeERC20TokenMetadataKey(common.HexToAddress(token.TokenContract)), k.cdc.MustMarshal(token))
+}
+
+// IterateERC20TokenMetadata iterates through the token registry entries by
+// token contract
+func (k Keeper) IterateERC20TokenMetadata(ctx sdk.Context, cb func(*types.ERC20TokenMetadata) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20TokenMetadataKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var token types.ERC20TokenMetadata
+		k.cdc.MustUnmarshal(iter.Value(), &token)
+		if cb(&token) {
+			break
+		}
+	}
+}
+
+// SetERC20TokenMetadata sets the token registry entry of an ethereum originated
+// ERC20 token. The vouchers of an allowed token get bank denom metadata, which
+// is kept if the token is disallowed again.
+func (k Keeper) SetERC20TokenMetadata(ctx sdk.Context, token types.ERC20TokenMetadata) error {
+	if err := token.ValidateBasic(); err != nil {
+		return err
+	}
+	token.TokenContract = common.HexToAddress(token.TokenContract).Hex()
+	if denom, isCosmosOriginated := k.getCosmosOriginatedDenom(ctx, token.TokenContract); isCosmosOriginated {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 token %s represents the cosmos originated denom %s", token.TokenContract, denom)
+	}
+
+	k.setERC20TokenMetadata(ctx, &token)
+	if token.Allowed {
+		if metadata, ok := token.BankMetadata(); ok {
+			k.bankKeeper.SetDenomMetaData(ctx, metadata)
+		}
+	}
+
+	k.emitTypedEvent(ctx, &types.EventERC20TokenRegistered{
+		TokenContract: token.TokenContract,
+		Name:          token.Name,
+		Symbol:        token.Symbol,
+		Decimals:      token.Decimals,
+		Allowed:       token.Allowed,
+	})
+	return nil
+}
+
+func (k Keeper) getUnregisteredERC20Policy(ctx sdk.Context) types.UnregisteredERC20Policy {
+	var policy types.UnregisteredERC20Policy
+	k.paramSpace.Get(ctx, types.ParamStoreUnregisteredERC20Policy, &policy)
+	return policy
+}
+
+// erc20DepositAllowed returns whether the deposits of an ethereum originated
+// ERC20 token can be credited: those of every token under the accept policy,
+// otherwise only those of the tokens allowed in the token registry
+func (k Keeper) erc20DepositAllowed(ctx sdk.Context, tokenContract common.Address) bool {
+	if k.getUnregisteredERC20Policy(ctx) == types.UnregisteredERC20PolicyAccept {
+		return true
+	}
+	token := k.GetERC20TokenMetadata(ctx, tokenContract)
+	return token != nil && token.Allowed
+}
+
+// rejectDeposit drops a deposit of a token which isn't allowed. It isn't sent
+// back to its ethereum sender: anyone can deploy a token and deposit it for
+// free, a refund would make the validators sign a batch for it.
+func (k Keeper) rejectDeposit(ctx sdk.Context, event *types.SendToCosmosEvent, amount sdk.Int) {
+	k.emitTypedEvent(ctx, &types.EventDepositRejected{
+		EventNonce:     event.EventNonce,
+		EthereumSender: event.EthereumSender,
+		CosmosReceiver: event.CosmosReceiver,
+		TokenContract:  event.TokenContract,
+		Amount:         amount,
+	})
+}
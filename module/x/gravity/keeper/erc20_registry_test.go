@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestSetERC20TokenMetadata(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.NewERC20Token(0, tokenContract.Hex()).GravityCoin().Denom
+
+	// a disallowed token has no bank metadata
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{
+		TokenContract: TokenContractAddrs[0],
+		Name:          "Dai Stablecoin",
+		Symbol:        "DAI",
+		Decimals:      18,
+	}))
+	token := k.GetERC20TokenMetadata(ctx, tokenContract)
+	require.NotNil(t, token)
+	require.Equal(t, tokenContract.Hex(), token.TokenContract)
+	_, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.False(t, found)
+
+	// an allowed token is displayed in its symbol with the ERC20 decimals
+	token.Allowed = true
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, *token))
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, denom, metadata.Base)
+	require.Equal(t, "DAI", metadata.Display)
+	require.Equal(t, "Dai Stablecoin", metadata.Name)
+	require.Equal(t, []*banktypes.DenomUnit{{Denom: denom}, {Denom: "DAI", Exponent: 18}}, metadata.DenomUnits)
+	require.NoError(t, metadata.Validate())
+
+	// a symbol which isn't a valid denom is not a display unit
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{
+		TokenContract: TokenContractAddrs[1],
+		Name:          "Yearn",
+		Symbol:        "$Y",
+		Decimals:      18,
+		Allowed:       true,
+	}))
+	metadata, found = input.BankKeeper.GetDenomMetaData(ctx, types.NewERC20Token(0, common.HexToAddress(TokenContractAddrs[1]).Hex()).GravityCoin().Denom)
+	require.True(t, found)
+	require.Equal(t, metadata.Base, metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+
+	res, err := k.ERC20Tokens(sdk.WrapSDKContext(ctx), &types.ERC20TokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Tokens, 2)
+
+	// cosmos originated tokens and invalid decimals can't be registered
+	k.setCosmosOriginatedDenomToERC20(ctx, "ugraviton", common.HexToAddress(TokenContractAddrs[2]).Hex())
+	require.Error(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{TokenContract: TokenContractAddrs[2], Allowed: true}))
+	require.Error(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{TokenContract: TokenContractAddrs[3], Decimals: 256}))
+}
+
+func TestUnregisteredERC20Policy(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.NewERC20Token(0, tokenContract.Hex()).GravityCoin().Denom
+
+	deposit := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 10,
+			Amount:         sdk.NewInt(1000),
+		}
+	}
+	setPolicy := func(policy types.UnregisteredERC20Policy) {
+		params := k.GetParams(ctx)
+		params.UnregisteredErc20Policy = policy
+		k.setParams(ctx, params)
+	}
+
+	// every token is accepted by default
+	observeDeposit(t, k, ctx, deposit(1))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+
+	// unregistered tokens are quarantined until they are allowed
+	setPolicy(types.UnregisteredERC20PolicyQuarantine)
+	observeDeposit(t, k, ctx, deposit(2))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 2))
+	require.Error(t, k.ResolveQuarantinedDeposit(ctx, 2, AccAddrs[0].String()))
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{TokenContract: tokenContract.Hex(), Allowed: true}))
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, 2, AccAddrs[0].String()))
+	require.Equal(t, sdk.NewInt(2000), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+
+	// allowed tokens are credited
+	observeDeposit(t, k, ctx, deposit(3))
+	require.Equal(t, sdk.NewInt(3000), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+
+	// disallowed tokens are dropped without refund
+	setPolicy(types.UnregisteredERC20PolicyReject)
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{TokenContract: tokenContract.Hex()}))
+	observeDeposit(t, k, ctx, deposit(4))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 4))
+	require.Equal(t, sdk.NewInt(3000), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+
+	countSends := func() (n int) {
+		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+			n++
+			return false
+		})
+		return n
+	}
+	require.Zero(t, countSends())
+
+	// quarantined deposits of disallowed tokens are dropped once expired
+	setPolicy(types.UnregisteredERC20PolicyQuarantine)
+	params := k.GetParams(ctx)
+	params.DepositQuarantineRefundDelay = 10
+	k.setParams(ctx, params)
+	observeDeposit(t, k, ctx, deposit(5))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 5))
+	k.RefundExpiredQuarantinedDeposits(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 5))
+	require.Zero(t, countSends())
+}
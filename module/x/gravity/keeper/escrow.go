@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// isBalanceDeltaAccountingToken returns true if the given ERC20 contract is
+// flagged for balance delta accounting, i.e. it is a fee-on-transfer or
+// rebasing token whose bridge contract balance cannot be derived from the
+// amounts sent to it
+func (k Keeper) isBalanceDeltaAccountingToken(ctx sdk.Context, tokenContract common.Address) bool {
+	var tokens []string
+	k.paramSpace.Get(ctx, types.ParamStoreBalanceDeltaAccountingTokens, &tokens)
+	for _, token := range tokens {
+		if common.HexToAddress(token) == tokenContract {
+			return true
+		}
+	}
+	return false
+}
+
+// GetERC20EscrowBalance returns the tracked bridge contract balance of a
+// balance delta accounting token. Until a balance is tracked, e.g. right after
+// the token was marked on a live chain, it is the initial escrow balance.
+func (k Keeper) GetERC20EscrowBalance(ctx sdk.Context, tokenContract common.Address) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeERC20EscrowBalanceKey(tokenContract))
+	if bz == nil {
+		return k.initialERC20EscrowBalance(ctx, tokenContract)
+	}
+	var balance sdk.Int
+	if err := balance.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return balance
+}
+
+func (k Keeper) setERC20EscrowBalance(ctx sdk.Context, tokenContract common.Address, balance sdk.Int) {
+	bz, err := balance.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeERC20EscrowBalanceKey(tokenContract), bz)
+}
+
+// iterateERC20EscrowBalances iterates through the tracked escrow balances by
+// token contract
+func (k Keeper) iterateERC20EscrowBalances(ctx sdk.Context, cb func(common.Address, sdk.Int) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20EscrowBalanceKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var balance sdk.Int
+		if err := balance.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(common.BytesToAddress(iter.Key()), balance) {
+			break
+		}
+	}
+}
+
+// initialERC20EscrowBalance returns the amount of a token the bridge contract
+// holds as far as the module can tell: the outstanding vouchers, the sends to
+// ethereum which aren't executed yet and the quarantined deposits
+func (k Keeper) initialERC20EscrowBalance(ctx sdk.Context, tokenContract common.Address) sdk.Int {
+	balance := k.bankKeeper.GetSupply(ctx, types.NewERC20Token(0, tokenContract.Hex()).GravityCoin().Denom).Amount
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+		balance = balance.Add(ste.Erc20Token.Amount).Add(ste.Erc20Fee.Amount)
+		return false
+	})
+	balance = balance.Add(k.getPendingBatchTotalByTokenType(ctx, tokenContract))
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		if common.HexToAddress(deposit.Event.TokenContract) == tokenContract {
+			balance = balance.Add(deposit.Amount)
+		}
+		return false
+	})
+	return balance
+}
+
+// updateERC20EscrowBalance sets the escrow balance of a token to the balance
+// reported by the orchestrators, which may be zero. If no balance was reported
+// the tracked balance is adjusted by delta instead, floored at zero.
+func (k Keeper) updateERC20EscrowBalance(ctx sdk.Context, tokenContract common.Address, reported sdk.Int, delta sdk.Int) {
+	if !reported.IsNil() {
+		k.setERC20EscrowBalance(ctx, tokenContract, reported)
+		return
+	}
+
+	balance := k.GetERC20EscrowBalance(ctx, tokenContract).Add(delta)
+	if balance.IsNegative() {
+		balance = sdk.ZeroInt()
+	}
+	k.setERC20EscrowBalance(ctx, tokenContract, balance)
+}
+
+// getPendingBatchTotalByTokenType returns the total amount, including fees, of
+// all batches for the given token that have not been executed yet
+func (k Keeper) getPendingBatchTotalByTokenType(ctx sdk.Context, tokenContract common.Address) sdk.Int {
+	total := sdk.ZeroInt()
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.BatchTx)
+		if common.HexToAddress(btx.TokenContract) == tokenContract {
+			total = total.Add(btx.GetTotal())
+		}
+		return false
+	})
+	return total
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestBalanceDeltaAccounting(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom               = types.NewERC20Token(0, myTokenContractAddr.Hex()).GravityCoin().Denom
+	)
+
+	params := gk.GetParams(ctx)
+	params.BalanceDeltaAccountingTokens = []string{myTokenContractAddr.Hex()}
+	gk.setParams(ctx, params)
+
+	// a deposit of 1000 which only credits 990 to the bridge contract
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  myTokenContractAddr.Hex(),
+		Amount:         sdk.NewInt(1000),
+		ReceivedAmount: sdk.NewInt(990),
+		EscrowBalance:  sdk.NewInt(990),
+		EthereumSender: myReceiver.Hex(),
+		CosmosReceiver: mySender.String(),
+		EthereumHeight: 100,
+	}))
+	require.Equal(t, sdk.NewInt(990), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Equal(t, sdk.NewInt(990), gk.GetERC20EscrowBalance(ctx, myTokenContractAddr))
+
+	// a deposit without a reported escrow balance adds the received amount
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     2,
+		TokenContract:  myTokenContractAddr.Hex(),
+		Amount:         sdk.NewInt(10),
+		ReceivedAmount: sdk.NewInt(10),
+		EthereumSender: myReceiver.Hex(),
+		CosmosReceiver: mySender.String(),
+		EthereumHeight: 101,
+	}))
+	require.Equal(t, sdk.NewInt(1000), gk.GetERC20EscrowBalance(ctx, myTokenContractAddr))
+
+	// the token rebases down, the batch would exceed the escrow and is refused
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     3,
+		TokenContract:  myTokenContractAddr.Hex(),
+		Amount:         sdk.NewInt(1),
+		ReceivedAmount: sdk.NewInt(1),
+		EscrowBalance:  sdk.NewInt(500),
+		EthereumSender: myReceiver.Hex(),
+		CosmosReceiver: mySender.String(),
+		EthereumHeight: 102,
+	}))
+	require.Equal(t, sdk.NewInt(500), gk.GetERC20EscrowBalance(ctx, myTokenContractAddr))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 300, 200)
+	require.Nil(t, gk.BuildBatchTx(ctx, myTokenContractAddr, 2))
+
+	// a batch within the escrow is created and accounted for
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+	require.Equal(t, sdk.NewInt(400), batch.GetTotal())
+	require.Nil(t, gk.BuildBatchTx(ctx, myTokenContractAddr, 1))
+
+	require.NoError(t, gk.Handle(ctx, &types.BatchExecutedEvent{
+		EventNonce:     4,
+		TokenContract:  myTokenContractAddr.Hex(),
+		EthereumHeight: 103,
+		BatchNonce:     batch.BatchNonce,
+	}))
+	require.Equal(t, sdk.NewInt(100), gk.GetERC20EscrowBalance(ctx, myTokenContractAddr))
+}
+
+func TestBalanceDeltaAccountingUnreportedAmounts(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.NewERC20Token(0, tokenContract.Hex()).GravityCoin().Denom
+
+	// vouchers minted before the token is marked are escrowed
+	supply := input.BankKeeper.GetSupply(ctx, denom).Amount
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500)))))
+	params := k.GetParams(ctx)
+	params.BalanceDeltaAccountingTokens = []string{tokenContract.Hex()}
+	k.setParams(ctx, params)
+	require.Equal(t, supply.AddRaw(500), k.GetERC20EscrowBalance(ctx, tokenContract))
+
+	// a deposit without a received amount is quarantined without credit
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+	require.Error(t, k.Handle(ctx, deposit))
+	require.Error(t, k.processEthereumEvent(ctx, deposit))
+	quarantined := k.GetQuarantinedDeposit(ctx, 1)
+	require.NotNil(t, quarantined)
+	require.True(t, quarantined.Amount.IsZero())
+	require.Equal(t, supply.AddRaw(500), input.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// refunding it sends nothing back
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, 1, ""))
+	require.Empty(t, k.getUnbatchedSendToEthereums(ctx))
+
+	// a reported zero escrow balance is not ignored
+	require.NoError(t, k.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     2,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(10),
+		ReceivedAmount: sdk.ZeroInt(),
+		EscrowBalance:  sdk.ZeroInt(),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 11,
+	}))
+	require.True(t, k.GetERC20EscrowBalance(ctx, tokenContract).IsZero())
+}
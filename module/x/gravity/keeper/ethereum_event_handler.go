@@ -21,34 +21,85 @@ func (k Keeper) DetectMaliciousSupply(ctx sdk.Context, denom string, amount sdk.
 	return nil
 }
 
+// sendToCosmosAmount returns the amount of vouchers the deposit is worth and
+// updates the escrow balance of balance delta accounting tokens. Fee-on-transfer
+// and rebasing tokens are credited with the balance delta of the bridge
+// contract rather than the amount sent to it, a deposit of them without a
+// received amount is worth nothing.
+func (k Keeper) sendToCosmosAmount(ctx sdk.Context, event *types.SendToCosmosEvent) (sdk.Int, error) {
+	tokenContract := common.HexToAddress(event.TokenContract)
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, event.TokenContract); isCosmosOriginated || !k.isBalanceDeltaAccountingToken(ctx, tokenContract) {
+		return event.Amount, nil
+	}
+	if event.ReceivedAmount.IsNil() {
+		k.updateERC20EscrowBalance(ctx, tokenContract, event.EscrowBalance, sdk.ZeroInt())
+		return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInvalid, "no received amount for balance delta accounting token %s", tokenContract.Hex())
+	}
+	k.updateERC20EscrowBalance(ctx, tokenContract, event.EscrowBalance, event.ReceivedAmount)
+	return event.ReceivedAmount, nil
+}
+
+// creditDeposit sends the vouchers of a deposit to the cosmos receiver,
+// minting them first if the token isn't cosmos originated. A deposit worth
+// nothing credits nothing.
+func (k Keeper) creditDeposit(ctx sdk.Context, tokenContract string, cosmosReceiver string, amount sdk.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(cosmosReceiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "cosmos receiver %s", cosmosReceiver)
+	}
+
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	coins := sdk.Coins{sdk.NewCoin(denom, amount)}
+
+	if !isCosmosOriginated {
+		if !k.erc20DepositAllowed(ctx, common.HexToAddress(tokenContract)) {
+			return sdkerrors.Wrapf(types.ErrERC20NotAllowed, "deposit of %s", tokenContract)
+		}
+		if err := k.DetectMaliciousSupply(ctx, denom, amount); err != nil {
+			return err
+		}
+
+		// if it is not cosmos originated, mint the coins (aka vouchers)
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+		}
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
 // Handle is the entry point for EthereumEvent processing
 func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
-		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, event.TokenContract)
-		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
-		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
-
-		if !isCosmosOriginated {
-			if err := k.DetectMaliciousSupply(ctx, denom, event.Amount); err != nil {
-				return err
-			}
-
-			// if it is not cosmos originated, mint the coins (aka vouchers)
-			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
-			}
+		amount, err := k.sendToCosmosAmount(ctx, event)
+		if err != nil {
+			return err
 		}
-
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		err = k.creditDeposit(ctx, event.TokenContract, event.CosmosReceiver, amount)
+		if types.ErrERC20NotAllowed.Is(err) && k.getUnregisteredERC20Policy(ctx) == types.UnregisteredERC20PolicyReject {
+			k.rejectDeposit(ctx, event, amount)
+			return nil
+		}
+		if err != nil {
 			return err
 		}
 		k.AfterSendToCosmosEvent(ctx, *event)
 		return nil
 
 	case *types.BatchExecutedEvent:
-		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce)
+		tokenContract := common.HexToAddress(event.TokenContract)
+		if k.isBalanceDeltaAccountingToken(ctx, tokenContract) {
+			otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, event.BatchNonce))
+			if batchTx, ok := otx.(*types.BatchTx); ok {
+				k.updateERC20EscrowBalance(ctx, tokenContract, event.EscrowBalance, batchTx.GetTotal().Neg())
+			}
+		}
+		k.batchTxExecuted(ctx, tokenContract, event.BatchNonce)
 		k.AfterBatchExecutedEvent(ctx, *event)
 		return nil
 
@@ -57,8 +108,21 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			return err
 		}
 
+		// anyone can deploy an ERC20 token for a denom, so only the deployment
+		// approved by governance is mapped and the others wait for an approval
+		if !k.isCosmosERC20Approved(ctx, event.CosmosDenom, common.HexToAddress(event.TokenContract)) {
+			k.setPendingERC20Deployment(ctx, event)
+			k.prunePendingERC20Deployments(ctx, event.CosmosDenom)
+			k.emitTypedEvent(ctx, &types.EventERC20DeploymentPending{
+				EventNonce:    event.EventNonce,
+				Denom:         event.CosmosDenom,
+				TokenContract: event.TokenContract,
+			})
+			return nil
+		}
+
 		// add to denom-erc20 mapping
-		k.setCosmosOriginatedDenomToERC20(ctx, event.CosmosDenom, event.TokenContract)
+		k.mapCosmosERC20(ctx, event.CosmosDenom, event.TokenContract)
 		k.AfterERC20DeployedEvent(ctx, *event)
 		return nil
 
@@ -74,6 +138,7 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
 		})
+		k.emitTypedEvent(ctx, &types.EventSignerSetTxExecuted{Nonce: event.SignerSetTxNonce})
 		k.AfterSignerSetExecutedEvent(ctx, *event)
 		return nil
 
@@ -83,10 +148,10 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 }
 
 func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
-	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
+	if existingDenom, exists := k.getCosmosOriginatedDenom(ctx, common.HexToAddress(event.TokenContract).Hex()); exists {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), event.CosmosDenom,
+			"ERC20 token %s already exists for denom %s", event.TokenContract, existingDenom,
 		)
 	}
 
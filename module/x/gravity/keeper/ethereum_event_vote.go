@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
 )
@@ -53,69 +54,166 @@ func (k Keeper) recordEventVote(
 	k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
 	k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
 
+	k.emitTypedEvent(ctx, &types.EventEthereumEventVote{
+		Validator:  val.String(),
+		EventType:  proto.MessageName(event),
+		EventNonce: event.GetEventNonce(),
+		EventHash:  event.Hash(),
+	})
+
 	return eventVoteRecord, nil
 }
 
+// validatorPowers caches the last validator powers of the staking module and
+// the power thresholds, so a tally reads the power of each voter at most once
+// per block
+type validatorPowers struct {
+	k          Keeper
+	ctx        sdk.Context
+	total      sdk.Int
+	thresholds *types.Params
+	powers     map[string]int64
+}
+
+func (k Keeper) newValidatorPowers(ctx sdk.Context) *validatorPowers {
+	return &validatorPowers{k: k, ctx: ctx, powers: make(map[string]int64)}
+}
+
+// requiredPower returns the power an event vote record of the event needs to
+// be observed
+func (vp *validatorPowers) requiredPower(event types.EthereumEvent) sdk.Int {
+	if vp.total.IsNil() {
+		vp.total = vp.k.StakingKeeper.GetLastTotalPower(vp.ctx)
+		vp.thresholds = vp.k.getPowerThresholds(vp.ctx)
+	}
+	return types.EventVoteRecordPowerThreshold(vp.total, vp.thresholds.EventVotePowerThresholdOf(event))
+}
+
+// power returns the last power of the validator
+func (vp *validatorPowers) power(validator string) int64 {
+	power, ok := vp.powers[validator]
+	if !ok {
+		val, _ := sdk.ValAddressFromBech32(validator)
+		power = vp.k.StakingKeeper.GetLastValidatorPower(vp.ctx, val)
+		vp.powers[validator] = power
+	}
+	return power
+}
+
+// TallyEventVoteRecords observes the event vote records which have passed the
+// threshold in order of nonce, starting at the nonce after the last observed
+// event. It stops at the first nonce without a record passing the threshold, so
+// only the records of the next nonces are read, however many are pending.
+func (k Keeper) TallyEventVoteRecords(ctx sdk.Context) {
+	powers := k.newValidatorPowers(ctx)
+	for nonce := k.GetLastObservedEventNonce(ctx) + 1; ; nonce++ {
+		// collect before trying, observing a record writes it to the store.
+		// There can be multiple records at one nonce when validators disagree
+		// about what event happened at that nonce.
+		var records []*types.EthereumEventVoteRecord
+		k.iterateEthereumEventVoteRecordsByNonce(ctx, nonce, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+			records = append(records, record)
+			return false
+		})
+
+		observed := false
+		for _, record := range records {
+			if !record.Accepted && k.tryEventVoteRecord(ctx, record, powers) {
+				observed = true
+				break
+			}
+		}
+		if !observed {
+			return
+		}
+	}
+}
+
 // TryEventVoteRecord checks if an event vote record has enough votes to be applied to the consensus state
 // and has not already been marked Observed, then calls processEthereumEvent to actually apply it to the state,
 // and then marks it Observed and emits an event.
 func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord) {
+	k.tryEventVoteRecord(ctx, eventVoteRecord, k.newValidatorPowers(ctx))
+}
+
+// tryEventVoteRecord implements TryEventVoteRecord with the powers of the
+// block and returns true if the record was observed
+func (k Keeper) tryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord, powers *validatorPowers) bool {
 	// If the event vote record has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the event vote record from accidentally being applied twice.
-	if !eventVoteRecord.Accepted {
-		var event types.EthereumEvent
-		if err := k.cdc.UnpackAny(eventVoteRecord.Event, &event); err != nil {
-			panic("unpacking packed any")
-		}
+	if eventVoteRecord.Accepted {
+		// We panic here because this should never happen
+		panic("attempting to process observed ethereum event")
+	}
 
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
-		eventVotePower := sdk.NewInt(0)
-		for _, validator := range eventVoteRecord.Votes {
-			val, _ := sdk.ValAddressFromBech32(validator)
-
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			eventVotePower = eventVotePower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if eventVotePower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if event.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, event.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
-
-				eventVoteRecord.Accepted = true
-				k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
-
-				k.processEthereumEvent(ctx, event)
-				ctx.EventManager().EmitEvent(sdk.NewEvent(
-					types.EventTypeObservation,
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
-					sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-					sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
-						string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
-					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
-				))
+	var event types.EthereumEvent
+	if err := k.cdc.UnpackAny(eventVoteRecord.Event, &event); err != nil {
+		panic("unpacking packed any")
+	}
 
-				break
+	// Sum the current powers of all validators who have voted and see if it passes the current threshold
+	// TODO: The different integer types and math here needs a careful review
+	requiredPower := powers.requiredPower(event)
+	eventVotePower := sdk.NewInt(0)
+	for _, validator := range eventVoteRecord.Votes {
+		// Add it to the attestation power's sum
+		eventVotePower = eventVotePower.Add(sdk.NewInt(powers.power(validator)))
+		// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
+		// process the attestation, set Observed to true, and break
+		if eventVotePower.GTE(requiredPower) {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx)
+			// this check is performed at the next level up so this should never panic
+			// outside of programmer error.
+			if event.GetEventNonce() != lastEventNonce+1 {
+				panic("attempting to apply events to state out of order")
+			}
+			k.setLastObservedEventNonce(ctx, event.GetEventNonce())
+			k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
+
+			eventVoteRecord.Accepted = true
+			k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+
+			observed := &types.EventEthereumEventObserved{
+				EventType:      proto.MessageName(event),
+				EventNonce:     event.GetEventNonce(),
+				EventHash:      event.Hash(),
+				EthereumHeight: event.GetEthereumHeight(),
+			}
+			if err := k.processEthereumEvent(ctx, event); err != nil {
+				observed.Error = err.Error()
 			}
+			k.emitTypedEvent(ctx, observed)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeObservation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
+				sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+				sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+					string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+			))
+
+			return true
 		}
-	} else {
-		// We panic here because this should never happen
-		panic("attempting to process observed ethereum event")
 	}
+	return false
 }
 
-// processEthereumEvent actually applies the attestation to the consensus state
-func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent) {
+// getPowerThresholds returns the params with the power thresholds of the
+// events
+func (k Keeper) getPowerThresholds(ctx sdk.Context) *types.Params {
+	params := &types.Params{}
+	k.paramSpace.Get(ctx, types.ParamStoreEventVotePowerThreshold, &params.EventVotePowerThreshold)
+	k.paramSpace.Get(ctx, types.ParamStoreEventTypePowerThresholds, &params.EventTypePowerThresholds)
+	k.paramSpace.Get(ctx, types.ParamStoreDepositPowerThresholds, &params.DepositPowerThresholds)
+	return params
+}
+
+// processEthereumEvent actually applies the attestation to the consensus state.
+// The returned error is informational, the state changes of a failed event
+// have already been discarded.
+func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent) error {
 	// then execute in a new Tx so that we can store state on failure
 	xCtx, commit := ctx.CacheContext()
 	if err := k.Handle(xCtx, event); err != nil { // execute with a transient storage
@@ -129,10 +227,21 @@ func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent)
 			"id", types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()),
 			"nonce", fmt.Sprint(event.GetEventNonce()),
 		)
-	} else {
-		ctx.EventManager().EmitEvents(xCtx.EventManager().Events()) // copy events to original context
-		commit()                                                    // persist transient storage
+
+		// The tokens of a failed deposit are locked in the bridge contract,
+		// quarantine it so that it can be credited or refunded later
+		if deposit, ok := event.(*types.SendToCosmosEvent); ok {
+			qCtx, commit := ctx.CacheContext()
+			k.quarantineDeposit(qCtx, deposit, err)
+			ctx.EventManager().EmitEvents(qCtx.EventManager().Events())
+			commit()
+		}
+		return err
 	}
+
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events()) // copy events to original context
+	commit()                                                    // persist transient storage
+	return nil
 }
 
 // setEthereumEventVoteRecord sets the attestation in the store
@@ -184,6 +293,22 @@ func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 	}
 }
 
+// iterateEthereumEventVoteRecordsByNonce iterates through the attestations at
+// an event nonce
+func (k Keeper) iterateEthereumEventVoteRecordsByNonce(ctx sdk.Context, eventNonce uint64, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumEventVoteRecordKey}, sdk.Uint64ToBigEndian(eventNonce)...))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		att := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), att)
+		// cb returns true to stop early
+		if cb(iter.Key(), att) {
+			return
+		}
+	}
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -214,12 +339,14 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.Latest
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LatestEthereumBlockHeight{
+	k.setLastObservedEthereumBlockHeight(ctx, types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   uint64(ctx.BlockHeight()),
-	}
-	store.Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
+	})
+}
+
+func (k Keeper) setLastObservedEthereumBlockHeight(ctx sdk.Context, height types.LatestEthereumBlockHeight) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
@@ -249,20 +376,25 @@ func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 		// params.SignedClaimsWindow we may have no attestations in our nonce. At which point
 		// the last observed which is a persistent and never cleaned counter will suffice.
 		lowestObserved := k.GetLastObservedEventNonce(ctx)
-		attmap := k.GetEthereumEventVoteRecordMapping(ctx)
+		// the records are stored in order of nonce, so the first accepted one
+		// is the lowest observed event
+		empty := true
+		k.iterateEthereumEventVoteRecords(ctx, func(key []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
+			empty = false
+			if !eventVoteRecord.Accepted {
+				return false
+			}
+			if nonce := binary.BigEndian.Uint64(key[:8]); nonce < lowestObserved {
+				lowestObserved = nonce
+			}
+			return true
+		})
 		// no new claims in params.SignedClaimsWindow, we can return the current value
 		// because the validator can't be slashed for an event that has already passed.
 		// so they only have to worry about the *next* event to occur
-		if len(attmap) == 0 {
+		if empty {
 			return lowestObserved
 		}
-		for nonce, atts := range attmap {
-			for att := range atts {
-				if atts[att].Accepted && nonce < lowestObserved {
-					lowestObserved = nonce
-				}
-			}
-		}
 		// return the latest event minus one so that the validator
 		// can submit that event and avoid slashing. special case
 		// for zero
@@ -279,3 +411,16 @@ func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.MakeLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
 }
+
+// iterateLastEventNonceByValidator iterates through the stored last event
+// nonces of the validators, without the fallback of getLastEventNonceByValidator
+// for the validators which never voted
+func (k Keeper) iterateLastEventNonceByValidator(ctx sdk.Context, cb func(sdk.ValAddress, uint64) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.LastEventNonceByValidatorKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// setVoteRecord stores a record of a contract call event at the nonce voted
+// by the first validators
+func setVoteRecord(t testing.TB, k Keeper, ctx sdk.Context, nonce uint64, scope byte, voters int) *types.ContractCallExecutedEvent {
+	event := &types.ContractCallExecutedEvent{
+		EventNonce:        nonce,
+		InvalidationScope: []byte{scope},
+		InvalidationNonce: nonce,
+		EthereumHeight:    nonce + 10,
+	}
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+	record := &types.EthereumEventVoteRecord{Event: any}
+	for _, val := range ValAddrs[:voters] {
+		record.Votes = append(record.Votes, val.String())
+	}
+	k.setEthereumEventVoteRecord(ctx, nonce, event.Hash(), record)
+	return event
+}
+
+func TestTallyEventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// nonce 2 has a record without enough votes next to one with enough votes,
+	// nonce 3 has none with enough votes and blocks nonce 4
+	first := setVoteRecord(t, k, ctx, 1, 0, 5)
+	minority := setVoteRecord(t, k, ctx, 2, 0, 1)
+	second := setVoteRecord(t, k, ctx, 2, 1, 4)
+	blocking := setVoteRecord(t, k, ctx, 3, 0, 2)
+	blocked := setVoteRecord(t, k, ctx, 4, 0, 5)
+
+	k.TallyEventVoteRecords(ctx)
+
+	require.EqualValues(t, 2, k.GetLastObservedEventNonce(ctx))
+	require.EqualValues(t, second.EthereumHeight, k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight)
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, 1, first.Hash()).Accepted)
+	require.False(t, k.GetEthereumEventVoteRecord(ctx, 2, minority.Hash()).Accepted)
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, 2, second.Hash()).Accepted)
+	require.False(t, k.GetEthereumEventVoteRecord(ctx, 3, blocking.Hash()).Accepted)
+	require.False(t, k.GetEthereumEventVoteRecord(ctx, 4, blocked.Hash()).Accepted)
+
+	// the missing votes of nonce 3 unblock nonce 4
+	record := k.GetEthereumEventVoteRecord(ctx, 3, blocking.Hash())
+	for _, val := range ValAddrs[2:] {
+		record.Votes = append(record.Votes, val.String())
+	}
+	k.setEthereumEventVoteRecord(ctx, 3, blocking.Hash(), record)
+
+	k.TallyEventVoteRecords(ctx)
+
+	require.EqualValues(t, 4, k.GetLastObservedEventNonce(ctx))
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, 4, blocked.Hash()).Accepted)
+
+	// the lowest accepted record bounds the nonce of a validator without votes
+	require.EqualValues(t, 0, k.getLastEventNonceByValidator(ctx, sdk.ValAddress("unknown")))
+}
+
+// BenchmarkEventVoteRecordTally measures the tally of a block with pending
+// records behind a nonce which hasn't got enough votes, which costs the same
+// however many records are pending
+func BenchmarkEventVoteRecordTally(b *testing.B) {
+	for _, pending := range []int{10, 1000, 10000} {
+		b.Run(sdk.NewInt(int64(pending)).String(), func(b *testing.B) {
+			input, ctx := SetupFiveValChain(b)
+			k := input.GravityKeeper
+
+			setVoteRecord(b, k, ctx, 1, 0, 2)
+			for nonce := uint64(2); nonce <= uint64(pending); nonce++ {
+				setVoteRecord(b, k, ctx, nonce, 0, 5)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.TallyEventVoteRecords(ctx)
+			}
+			b.StopTimer()
+			require.Zero(b, k.GetLastObservedEventNonce(ctx))
+		})
+	}
+}
+
+func TestTallyEventVoteRecordsPowerThresholds(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// every validator has a fifth of the power
+	params := k.GetParams(ctx)
+	params.EventTypePowerThresholds = []types.EventTypePowerThreshold{
+		{EventType: "gravity.v1.SignerSetTxExecutedEvent", Threshold: sdk.OneDec()},
+	}
+	params.DepositPowerThresholds = []types.DepositPowerThreshold{
+		{TokenContract: EthAddrs[0].Hex(), Amount: sdk.NewInt(1000), Threshold: sdk.NewDecWithPrec(9, 1)},
+	}
+	k.setParams(ctx, params)
+
+	vote := func(event types.EthereumEvent, voters int) {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		record := &types.EthereumEventVoteRecord{Event: any}
+		for _, val := range ValAddrs[:voters] {
+			record.Votes = append(record.Votes, val.String())
+		}
+		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), record)
+		k.TallyEventVoteRecords(ctx)
+	}
+	deposit := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 10,
+			Amount:         sdk.NewInt(amount),
+		}
+	}
+
+	// a small deposit needs the default threshold
+	vote(deposit(1, 999), 4)
+	require.EqualValues(t, 1, k.GetLastObservedEventNonce(ctx))
+
+	// a large deposit needs all votes
+	large := deposit(2, 1000)
+	vote(large, 4)
+	require.EqualValues(t, 1, k.GetLastObservedEventNonce(ctx))
+	vote(large, 5)
+	require.EqualValues(t, 2, k.GetLastObservedEventNonce(ctx))
+
+	// so does a signer set update
+	signerSet := &types.SignerSetTxExecutedEvent{EventNonce: 3, SignerSetTxNonce: 1, EthereumHeight: 11}
+	vote(signerSet, 4)
+	require.EqualValues(t, 2, k.GetLastObservedEventNonce(ctx))
+	vote(signerSet, 5)
+	require.EqualValues(t, 3, k.GetLastObservedEventNonce(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +14,24 @@ import (
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.setParams(ctx, *data.Params)
 
+	// reset delegate keys in state, the ethereum signatures of the
+	// delegations aren't stored so exported keys don't have one
+	for _, keys := range data.DelegateKeys {
+		if err := keys.ValidateBasic(); err != nil && !types.ErrEmptyEthSig.Is(err) {
+			panic("Invalid delegate key in Genesis!")
+		}
+
+		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
+		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
+		eth := common.HexToAddress(keys.EthereumAddress)
+
+		// set the orchestrator address
+		k.SetOrchestratorValidatorAddress(ctx, val, orch)
+		// set the ethereum address
+		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
+		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+	}
+
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedSendToEthereumTxs {
 		k.setUnbatchedSendToEthereum(ctx, tx)
@@ -47,21 +67,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
-	// reset delegate keys in state
-	for _, keys := range data.DelegateKeys {
-		if err := keys.ValidateBasic(); err != nil {
-			panic("Invalid delegate key in Genesis!")
+	// the exported last event nonces take precedence over the ones derived
+	// from the vote records, which may have been pruned
+	for _, item := range data.LastEventNoncesByValidator {
+		val, err := sdk.ValAddressFromBech32(item.ValidatorAddress)
+		if err != nil {
+			panic(err)
 		}
-
-		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
-		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
-		eth := common.HexToAddress(keys.EthereumAddress)
-
-		// set the orchestrator address
-		k.SetOrchestratorValidatorAddress(ctx, val, orch)
-		// set the ethereum address
-		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
-		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+		k.setLastEventNonceByValidator(ctx, val, item.EventNonce)
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
@@ -69,6 +82,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, item.Erc20)
 	}
 
+	// the previous token contracts of remapped denoms only map to their denom
+	for _, item := range data.RemappedErc20ToDenoms {
+		ctx.KVStore(k.storeKey).Set(types.MakeERC20ToDenomKey(item.Erc20), []byte(item.Denom))
+	}
+
 	// reset outgoing txs in state
 	for _, ota := range data.OutgoingTxs {
 		otx, err := types.UnpackOutgoingTx(ota)
@@ -78,16 +96,122 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOutgoingTx(ctx, otx)
 	}
 
-	// reset signatures in state
+	// reset signatures in state, confirmations only record the ethereum
+	// signer so they are mapped back to the validators which delegated to it
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
 		if err != nil {
 			panic("invalid etheruem signature in genesis")
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+		vals := k.getValidatorsByEthereumAddress(ctx, conf.GetSigner())
+		if len(vals) == 0 {
+			panic(fmt.Sprintf("no validator for ethereum signer %s in genesis", conf.GetSigner().Hex()))
+		}
+		for _, val := range vals {
+			k.SetEthereumSignature(ctx, conf, val)
+		}
+	}
+
+	// reset counters and the latest observed ethereum state
+	k.setLastOutgoingBatchNonce(ctx, data.LastOutgoingBatchNonce)
+	k.setLastSendToEthereumID(ctx, data.LastSendToEthereumId)
+	k.setLatestSignerSetTxNonce(ctx, data.LatestSignerSetTxNonce)
+	if data.LastObservedSignerSetTx != nil {
+		k.setLastObservedSignerSetTx(ctx, *data.LastObservedSignerSetTx)
+	}
+	if data.LastObservedEthereumHeight != nil {
+		k.setLastObservedEthereumBlockHeight(ctx, *data.LastObservedEthereumHeight)
+	}
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, data.LastSlashedOutgoingTxBlockHeight)
+	k.setLastUnbondingBlockHeight(ctx, data.LastUnbondingBlockHeight)
+	k.setLastEthereumAddressChangeBlockHeight(ctx, data.LastEthereumAddressChangeBlockHeight)
+
+	// reset escrow balances of balance delta accounting tokens
+	for _, item := range data.Erc20EscrowBalances {
+		k.setERC20EscrowBalance(ctx, common.HexToAddress(item.TokenContract), item.Balance)
+	}
+
+	// reset outgoing tx signing infos and missed outgoing txs
+	for _, info := range data.OutgoingTxSigningInfos {
+		val, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setOutgoingTxSigningInfo(ctx, val, *info)
+	}
+	for _, missed := range data.MissedOutgoingTxs {
+		val, err := sdk.ValAddressFromBech32(missed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		for _, index := range missed.Indexes {
+			k.setMissedOutgoingTxBit(ctx, val, index, true)
+		}
+	}
+
+	// reset quarantined deposits
+	for _, deposit := range data.QuarantinedDeposits {
+		k.setQuarantinedDeposit(ctx, deposit)
+	}
+
+	// reset the token registry, the bank denom metadata of allowed tokens is
+	// in the bank genesis
+	for _, token := range data.Erc20Tokens {
+		k.setERC20TokenMetadata(ctx, token)
+	}
+
+	for _, approval := range data.CosmosErc20Approvals {
+		k.setCosmosERC20Approval(ctx, approval)
+	}
+	for _, deployment := range data.PendingErc20Deployments {
+		k.setPendingERC20Deployment(ctx, deployment)
+	}
+}
+
+// PrepForZeroHeightGenesis rebases the Cosmos heights stored by the module for
+// a restart at height zero. Like the creation heights of staking entries they
+// are all reset to zero, so windows and delays count from the restart and the
+// outgoing txs pending at the export are no longer counted for signing. The
+// Ethereum height projected at the export becomes the last observed one, so
+// batch timeouts keep being projected from it.
+func (k Keeper) PrepForZeroHeightGenesis(ctx sdk.Context) {
+	if projected := k.getProjectedEthereumHeight(ctx); projected != 0 {
+		k.setLastObservedEthereumBlockHeight(ctx, types.LatestEthereumBlockHeight{EthereumHeight: projected})
+	}
+
+	var otxs []types.OutgoingTx
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		otxs = append(otxs, otx)
+		return false
+	})
+	for _, otx := range otxs {
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			otx.Height = 0
+		case *types.BatchTx:
+			otx.Height = 0
+		case *types.ContractCallTx:
+			otx.Height = 0
+		}
+		k.SetOutgoingTx(ctx, otx)
+	}
+	if sstx := k.GetLastObservedSignerSetTx(ctx); sstx != nil {
+		sstx.Height = 0
+		k.setLastObservedSignerSetTx(ctx, *sstx)
+	}
+
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, 0)
+	k.setLastUnbondingBlockHeight(ctx, 0)
+	k.setLastEthereumAddressChangeBlockHeight(ctx, 0)
+
+	var deposits []*types.QuarantinedDeposit
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	for _, deposit := range deposits {
+		deposit.Height = 0
+		k.setQuarantinedDeposit(ctx, deposit)
 	}
 }
 
@@ -98,26 +222,94 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		p                        = k.GetParams(ctx)
 		outgoingTxs              []*cdctypes.Any
 		ethereumTxConfirmations  []*cdctypes.Any
-		attmap                   = k.GetEthereumEventVoteRecordMapping(ctx)
 		ethereumEventVoteRecords []*types.EthereumEventVoteRecord
 		delegates                = k.getDelegateKeys(ctx)
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms            []*types.ERC20ToDenom
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		lastEventNonces          []*types.LastEventNonceByValidator
+		escrowBalances           []*types.ERC20EscrowBalance
+		signingInfos             []*types.OutgoingTxSigningInfo
+		missedOutgoingTxs        []*types.MissedOutgoingTxs
+		quarantinedDeposits      []*types.QuarantinedDeposit
+		erc20Tokens              []*types.ERC20TokenMetadata
+		remappedERC20ToDenoms    []*types.ERC20ToDenom
+		cosmosERC20Approvals     []*types.CosmosERC20Approval
+		pendingERC20Deployments  []*types.ERC20DeployedEvent
+		lastObservedHeight       = k.GetLastObservedEthereumBlockHeight(ctx)
 	)
 
-	// export ethereumEventVoteRecords from state
-	for _, atts := range attmap {
-		// TODO: set height = 0?
-		ethereumEventVoteRecords = append(ethereumEventVoteRecords, atts...)
-	}
+	// export ethereumEventVoteRecords from state in event nonce order
+	k.iterateEthereumEventVoteRecords(ctx, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+		ethereumEventVoteRecords = append(ethereumEventVoteRecords, record)
+		return false
+	})
 
-	// export erc20 to denom relations
+	// export the last event nonces the validators voted for
+	k.iterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, &types.LastEventNonceByValidator{
+			ValidatorAddress: val.String(),
+			EventNonce:       nonce,
+		})
+		return false
+	})
+
+	// export escrow balances of balance delta accounting tokens
+	k.iterateERC20EscrowBalances(ctx, func(tokenContract common.Address, balance sdk.Int) bool {
+		escrowBalances = append(escrowBalances, &types.ERC20EscrowBalance{
+			TokenContract: tokenContract.Hex(),
+			Balance:       balance,
+		})
+		return false
+	})
+
+	// export outgoing tx signing infos and missed outgoing txs
+	k.IterateOutgoingTxSigningInfos(ctx, func(val sdk.ValAddress, info types.OutgoingTxSigningInfo) bool {
+		signingInfos = append(signingInfos, &info)
+		missed := &types.MissedOutgoingTxs{ValidatorAddress: val.String()}
+		k.iterateMissedOutgoingTxBits(ctx, val, func(index uint64) bool {
+			missed.Indexes = append(missed.Indexes, index)
+			return false
+		})
+		if len(missed.Indexes) > 0 {
+			missedOutgoingTxs = append(missedOutgoingTxs, missed)
+		}
+		return false
+	})
+
+	// export quarantined deposits
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		quarantinedDeposits = append(quarantinedDeposits, deposit)
+		return false
+	})
+
+	// export the token registry
+	k.IterateERC20TokenMetadata(ctx, func(token *types.ERC20TokenMetadata) bool {
+		erc20Tokens = append(erc20Tokens, token)
+		return false
+	})
+
+	// export erc20 to denom relations, apart from the previous token contracts
+	// of remapped denoms
 	k.iterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		if current, _ := k.getCosmosOriginatedERC20(ctx, erc20ToDenom.Denom); current != common.HexToAddress(erc20ToDenom.Erc20) {
+			remappedERC20ToDenoms = append(remappedERC20ToDenoms, erc20ToDenom)
+			return false
+		}
 		erc20ToDenoms = append(erc20ToDenoms, erc20ToDenom)
 		return false
 	})
 
+	// export the approved and the pending ERC20 representations
+	k.IterateCosmosERC20Approvals(ctx, func(approval *types.CosmosERC20Approval) bool {
+		cosmosERC20Approvals = append(cosmosERC20Approvals, approval)
+		return false
+	})
+	k.IteratePendingERC20Deployments(ctx, func(deployment *types.ERC20DeployedEvent) bool {
+		pendingERC20Deployments = append(pendingERC20Deployments, deployment)
+		return false
+	})
+
 	// export signer set txs and sigs
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
@@ -158,13 +350,30 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                               &p,
+		LastObservedEventNonce:               lastobserved,
+		OutgoingTxs:                          outgoingTxs,
+		Confirmations:                        ethereumTxConfirmations,
+		EthereumEventVoteRecords:             ethereumEventVoteRecords,
+		DelegateKeys:                         delegates,
+		Erc20ToDenoms:                        erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:           unbatchedTransfers,
+		LastOutgoingBatchNonce:               k.getLastOutgoingBatchNonce(ctx),
+		LastSendToEthereumId:                 k.getLastSendToEthereumID(ctx),
+		LatestSignerSetTxNonce:               k.GetLatestSignerSetTxNonce(ctx),
+		LastObservedSignerSetTx:              k.GetLastObservedSignerSetTx(ctx),
+		LastObservedEthereumHeight:           &lastObservedHeight,
+		LastSlashedOutgoingTxBlockHeight:     k.GetLastSlashedOutgoingTxBlockHeight(ctx),
+		LastUnbondingBlockHeight:             k.GetLastUnbondingBlockHeight(ctx),
+		LastEthereumAddressChangeBlockHeight: k.GetLastEthereumAddressChangeBlockHeight(ctx),
+		LastEventNoncesByValidator:           lastEventNonces,
+		Erc20EscrowBalances:                  escrowBalances,
+		OutgoingTxSigningInfos:               signingInfos,
+		MissedOutgoingTxs:                    missedOutgoingTxs,
+		QuarantinedDeposits:                  quarantinedDeposits,
+		Erc20Tokens:                          erc20Tokens,
+		CosmosErc20Approvals:                 cosmosERC20Approvals,
+		PendingErc20Deployments:              pendingERC20Deployments,
+		RemappedErc20ToDenoms:                remappedERC20ToDenoms,
 	}
 }
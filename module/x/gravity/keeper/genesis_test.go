@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin()}
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// pool and batch with signatures
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1, 2, 3)
+	batch := k.BuildBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	for i, val := range ValAddrs[:3] {
+		k.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: EthAddrs[i].Hex(),
+			Signature:      []byte{byte(i + 1)},
+		}, val)
+	}
+
+	// signer set txs with signatures
+	sstx := k.CreateSignerSetTx(ctx)
+	require.NotNil(t, sstx)
+	k.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: sstx.Nonce,
+		EthereumSigner: EthAddrs[4].Hex(),
+		Signature:      []byte{0xaa},
+	}, ValAddrs[4])
+	k.setLastObservedSignerSetTx(ctx, *sstx)
+
+	// observed and quarantined deposits
+	deposit := badDeposit(1, EthAddrs[0].Hex())
+	deposit.CosmosReceiver = authtypes.NewModuleAddress(types.ModuleName).String()
+	observeDeposit(t, k, ctx, deposit)
+	for _, val := range ValAddrs {
+		k.setLastEventNonceByValidator(ctx, val, 1)
+	}
+	k.SetLastObservedEthereumBlockHeight(ctx, 1234)
+
+	// counters, indexes and signing infos
+	k.setCosmosOriginatedDenomToERC20(ctx, "ugraviton", "0x3Cc0bB2Fb1fE0C6B8a2Bf35e3DfDEd9f1c0a1B4e")
+	k.mapCosmosERC20(ctx, "ugraviton", common.HexToAddress(TokenContractAddrs[1]).Hex())
+	require.NoError(t, k.ApproveCosmosERC20(ctx, "uatom", TokenContractAddrs[2]))
+	k.setPendingERC20Deployment(ctx, &types.ERC20DeployedEvent{
+		CosmosDenom:    "stake",
+		TokenContract:  common.HexToAddress(TokenContractAddrs[3]).Hex(),
+		Erc20Name:      "stake",
+		EventNonce:     1,
+		EthereumHeight: 10,
+	})
+	k.setERC20EscrowBalance(ctx, myTokenContractAddr, sdk.NewInt(5000))
+	require.NoError(t, k.SetERC20TokenMetadata(ctx, types.ERC20TokenMetadata{TokenContract: myTokenContractAddr.Hex(), Name: "Token", Symbol: "TKN", Decimals: 6, Allowed: true}))
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, 7)
+	k.setLastUnbondingBlockHeight(ctx, 8)
+	k.setLastEthereumAddressChangeBlockHeight(ctx, 9)
+	k.setOutgoingTxSigningInfo(ctx, ValAddrs[2], types.OutgoingTxSigningInfo{
+		ValidatorAddress:         ValAddrs[2].String(),
+		IndexOffset:              4,
+		MissedOutgoingTxsCounter: 2,
+		JailedUntil:              time.Unix(1000, 0).UTC(),
+	})
+	k.setMissedOutgoingTxBit(ctx, ValAddrs[2], 1, true)
+	k.setMissedOutgoingTxBit(ctx, ValAddrs[2], 3, true)
+
+	exported := ExportGenesis(ctx, k)
+	require.NotEmpty(t, exported.Confirmations)
+	require.NotEmpty(t, exported.LastEventNoncesByValidator)
+	require.NotEmpty(t, exported.QuarantinedDeposits)
+	require.NotEmpty(t, exported.Erc20Tokens)
+	require.Len(t, exported.Erc20ToDenoms, 1)
+	require.Len(t, exported.RemappedErc20ToDenoms, 1)
+	require.NotEmpty(t, exported.CosmosErc20Approvals)
+	require.NotEmpty(t, exported.PendingErc20Deployments)
+	require.EqualValues(t, 1, exported.LastOutgoingBatchNonce)
+	require.EqualValues(t, 3, exported.LastSendToEthereumId)
+	require.Equal(t, []*types.MissedOutgoingTxs{{ValidatorAddress: ValAddrs[2].String(), Indexes: []uint64{1, 3}}}, exported.MissedOutgoingTxs)
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, exported)
+	require.Equal(t, exported, ExportGenesis(imported.Context, imported.GravityKeeper))
+
+	// signatures are mapped back to their validators through the ethereum
+	// address index
+	ik := imported.GravityKeeper
+	require.Equal(t, []byte{0xaa}, ik.getEthereumSignature(imported.Context, sstx.GetStoreIndex(), ValAddrs[4]))
+	require.Equal(t, []byte{2}, ik.getEthereumSignature(imported.Context, batch.GetStoreIndex(), ValAddrs[1]))
+	require.Equal(t, batch.BatchNonce, ik.getLastOutgoingBatchByTokenType(imported.Context, myTokenContractAddr).BatchNonce)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
@@ -47,6 +49,16 @@ func (k Keeper) LatestSignerSetTx(c context.Context, req *types.LatestSignerSetT
 	return &types.SignerSetTxResponse{SignerSet: ss}, nil
 }
 
+func (k Keeper) LastObservedSignerSetTx(c context.Context, req *types.LastObservedSignerSetTxRequest) (*types.SignerSetTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ss := k.GetLastObservedSignerSetTx(ctx)
+	if ss == nil {
+		return nil, status.Errorf(codes.NotFound, "last observed signer set not found")
+	}
+	return &types.SignerSetTxResponse{SignerSet: ss}, nil
+}
+
 func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*types.SignerSetTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -387,15 +399,32 @@ func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.Unbatched
 	ctx := sdk.UnwrapSDKContext(c)
 	res := &types.UnbatchedSendToEthereumsResponse{}
 
+	if req.SenderAddress != "" {
+		sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		prefixKey := append([]byte{types.SendToEthereumSenderKey}, address.MustLengthPrefix(sender)...)
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+		pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, _ []byte) error {
+			if ste := k.getUnbatchedSendToEthereum(ctx, binary.BigEndian.Uint64(key)); ste != nil {
+				res.SendToEthereums = append(res.SendToEthereums, ste)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.Pagination = pageRes
+		return res, nil
+	}
+
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
 		var ste types.SendToEthereum
 		k.cdc.MustUnmarshal(value, &ste)
-		if ste.Sender == req.SenderAddress {
-			res.SendToEthereums = append(res.SendToEthereums, &ste)
-			return true, nil
-		}
-		return false, nil
+		res.SendToEthereums = append(res.SendToEthereums, &ste)
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -459,3 +488,100 @@ func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest)
 	}
 	return res, nil
 }
+
+func (k Keeper) ERC20EscrowBalance(c context.Context, req *types.ERC20EscrowBalanceRequest) (*types.ERC20EscrowBalanceResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	tokenContract := common.HexToAddress(req.TokenContract)
+	res := &types.ERC20EscrowBalanceResponse{
+		EscrowBalance:          k.GetERC20EscrowBalance(ctx, tokenContract),
+		BalanceDeltaAccounting: k.isBalanceDeltaAccountingToken(ctx, tokenContract),
+	}
+	return res, nil
+}
+
+func (k Keeper) NextSignerSetTxDecision(c context.Context, req *types.NextSignerSetTxDecisionRequest) (*types.NextSignerSetTxDecisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	// the begin blocker of the next block decides on the state of this one
+	decision := k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	return &types.NextSignerSetTxDecisionResponse{Decision: decision}, nil
+}
+
+func (k Keeper) OutgoingTxSigningInfo(c context.Context, req *types.OutgoingTxSigningInfoRequest) (*types.OutgoingTxSigningInfoResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.OutgoingTxSigningInfoResponse{SigningInfo: k.GetOutgoingTxSigningInfo(ctx, val)}, nil
+}
+
+func (k Keeper) QuarantinedDeposits(c context.Context, req *types.QuarantinedDepositsRequest) (*types.QuarantinedDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QuarantinedDepositsResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.QuarantinedDepositKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(value, &deposit)
+		res.Deposits = append(res.Deposits, &deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+func (k Keeper) ERC20Tokens(c context.Context, req *types.ERC20TokensRequest) (*types.ERC20TokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ERC20TokensResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20TokenMetadataKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var token types.ERC20TokenMetadata
+		k.cdc.MustUnmarshal(value, &token)
+		res.Tokens = append(res.Tokens, &token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+func (k Keeper) CosmosERC20Approvals(c context.Context, req *types.CosmosERC20ApprovalsRequest) (*types.CosmosERC20ApprovalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.CosmosERC20ApprovalsResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.CosmosERC20ApprovalKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var approval types.CosmosERC20Approval
+		k.cdc.MustUnmarshal(value, &approval)
+		res.Approvals = append(res.Approvals, &approval)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+func (k Keeper) PendingERC20Deployments(c context.Context, req *types.PendingERC20DeploymentsRequest) (*types.PendingERC20DeploymentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.PendingERC20DeploymentsResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingERC20DeploymentKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var event types.ERC20DeployedEvent
+		k.cdc.MustUnmarshal(value, &event)
+		res.Deployments = append(res.Deployments, &event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
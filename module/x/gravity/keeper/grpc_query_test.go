@@ -48,6 +48,21 @@ func TestKeeper_LatestSignerSetTx(t *testing.T) {
 	})
 }
 
+func TestKeeper_LastObservedSignerSetTx(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	_, err := gk.LastObservedSignerSetTx(sdk.WrapSDKContext(ctx), &types.LastObservedSignerSetTxRequest{})
+	require.Error(t, err)
+
+	sstx := types.SignerSetTx{Nonce: 3, Signers: types.EthereumSigners{{Power: 100, EthereumAddress: EthAddrs[0].Hex()}}}
+	gk.setLastObservedSignerSetTx(ctx, sstx)
+	res, err := gk.LastObservedSignerSetTx(sdk.WrapSDKContext(ctx), &types.LastObservedSignerSetTxRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &sstx, res.SignerSet)
+}
+
 func TestKeeper_SignerSetTx(t *testing.T) {
 	t.Run("read after there's something in state", func(t *testing.T) {
 		env := CreateTestEnv(t)
@@ -234,3 +249,34 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_UnbatchedSendToEthereums(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	token := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	senders := []string{
+		sdk.AccAddress(bytes.HexBytes{1}).String(),
+		sdk.AccAddress(bytes.HexBytes{2}).String(),
+		sdk.AccAddress(bytes.HexBytes{1}).String(),
+	}
+	for i, sender := range senders {
+		gk.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
+			Id:                uint64(i + 1),
+			Sender:            sender,
+			EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			Erc20Token:        types.NewERC20Token(100, token),
+			Erc20Fee:          types.NewERC20Token(uint64(i+1), token),
+		})
+	}
+
+	res, err := gk.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{SenderAddress: senders[0]})
+	require.NoError(t, err)
+	require.Len(t, res.SendToEthereums, 2)
+
+	// the whole pool without a sender
+	res, err = gk.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SendToEthereums, 3)
+}
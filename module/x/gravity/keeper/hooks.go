@@ -71,6 +71,64 @@ func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, event types.SendToCosmos
 	}
 }
 
+func (k Keeper) BeforeSendToEthereum(ctx sdk.Context, ste types.SendToEthereum) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeSendToEthereum(ctx, ste)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSendToEthereum(ctx sdk.Context, ste types.SendToEthereum) {
+	if k.hooks != nil {
+		k.hooks.AfterSendToEthereum(ctx, ste)
+	}
+}
+
+func (k Keeper) BeforeCancelSendToEthereum(ctx sdk.Context, ste types.SendToEthereum) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeCancelSendToEthereum(ctx, ste)
+	}
+	return nil
+}
+
+func (k Keeper) AfterCancelSendToEthereum(ctx sdk.Context, ste types.SendToEthereum) {
+	if k.hooks != nil {
+		k.hooks.AfterCancelSendToEthereum(ctx, ste)
+	}
+}
+
+func (k Keeper) BeforeBatchTxCreated(ctx sdk.Context, batch types.BatchTx) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeBatchTxCreated(ctx, batch)
+	}
+	return nil
+}
+
+func (k Keeper) AfterBatchTxCreated(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchTxCreated(ctx, batch)
+	}
+}
+
+func (k Keeper) AfterBatchTxTimedOut(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchTxTimedOut(ctx, batch)
+	}
+}
+
+func (k Keeper) BeforeSignerSetTxCreated(ctx sdk.Context, signerSetTx types.SignerSetTx) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeSignerSetTxCreated(ctx, signerSetTx)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSignerSetTxCreated(ctx sdk.Context, signerSetTx types.SignerSetTx) {
+	if k.hooks != nil {
+		k.hooks.AfterSignerSetTxCreated(ctx, signerSetTx)
+	}
+}
+
 func (k *Keeper) SetHooks(sh types.GravityHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set gravity hooks twice")
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// vetoHooks records the lifecycle hooks called and vetoes the actions for
+// which veto is set
+type vetoHooks struct {
+	calls []string
+	veto  map[string]bool
+}
+
+func (h *vetoHooks) call(name string) error {
+	h.calls = append(h.calls, name)
+	if h.veto[name] {
+		return errors.New("vetoed")
+	}
+	return nil
+}
+
+func (h *vetoHooks) AfterContractCallExecutedEvent(sdk.Context, types.ContractCallExecutedEvent) {}
+func (h *vetoHooks) AfterERC20DeployedEvent(sdk.Context, types.ERC20DeployedEvent)               {}
+func (h *vetoHooks) AfterSignerSetExecutedEvent(sdk.Context, types.SignerSetTxExecutedEvent)     {}
+func (h *vetoHooks) AfterBatchExecutedEvent(sdk.Context, types.BatchExecutedEvent)               {}
+func (h *vetoHooks) AfterSendToCosmosEvent(sdk.Context, types.SendToCosmosEvent)                 {}
+
+func (h *vetoHooks) BeforeSendToEthereum(sdk.Context, types.SendToEthereum) error {
+	return h.call("BeforeSendToEthereum")
+}
+func (h *vetoHooks) AfterSendToEthereum(sdk.Context, types.SendToEthereum) {
+	h.call("AfterSendToEthereum")
+}
+func (h *vetoHooks) BeforeCancelSendToEthereum(sdk.Context, types.SendToEthereum) error {
+	return h.call("BeforeCancelSendToEthereum")
+}
+func (h *vetoHooks) AfterCancelSendToEthereum(sdk.Context, types.SendToEthereum) {
+	h.call("AfterCancelSendToEthereum")
+}
+func (h *vetoHooks) BeforeBatchTxCreated(sdk.Context, types.BatchTx) error {
+	return h.call("BeforeBatchTxCreated")
+}
+func (h *vetoHooks) AfterBatchTxCreated(sdk.Context, types.BatchTx) {
+	h.call("AfterBatchTxCreated")
+}
+func (h *vetoHooks) AfterBatchTxTimedOut(sdk.Context, types.BatchTx) {
+	h.call("AfterBatchTxTimedOut")
+}
+func (h *vetoHooks) BeforeSignerSetTxCreated(sdk.Context, types.SignerSetTx) error {
+	return h.call("BeforeSignerSetTxCreated")
+}
+func (h *vetoHooks) AfterSignerSetTxCreated(sdk.Context, types.SignerSetTx) {
+	h.call("AfterSignerSetTxCreated")
+}
+
+func TestOutgoingLifecycleHooks(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin()}
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// the second hooks aren't called once the first vetoes
+	first := &vetoHooks{veto: map[string]bool{}}
+	second := &vetoHooks{veto: map[string]bool{}}
+	input.GravityKeeper.SetHooks(types.NewMultiGravityHooks(first, second))
+	k := input.GravityKeeper
+
+	amount := types.NewERC20Token(100, myTokenContractAddr.Hex()).GravityCoin()
+	fee := types.NewERC20Token(1, myTokenContractAddr.Hex()).GravityCoin()
+
+	// a vetoed send to ethereum isn't added to the pool
+	first.veto["BeforeSendToEthereum"] = true
+	_, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.Error(t, err)
+	require.Equal(t, []string{"BeforeSendToEthereum"}, first.calls)
+	require.Empty(t, second.calls)
+	require.Equal(t, allVouchers, input.BankKeeper.GetAllBalances(ctx, mySender))
+
+	first.veto["BeforeSendToEthereum"] = false
+	first.calls, second.calls = nil, nil
+	id, err := k.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BeforeSendToEthereum", "AfterSendToEthereum"}, second.calls)
+
+	// a vetoed cancellation leaves the send to ethereum in the pool
+	second.veto["BeforeCancelSendToEthereum"] = true
+	require.Error(t, k.cancelSendToEthereum(ctx, id, mySender.String()))
+	require.NotNil(t, k.getUnbatchedSendToEthereum(ctx, id))
+
+	// a vetoed batch leaves the pool and the batch nonce as they were
+	second.veto["BeforeBatchTxCreated"] = true
+	require.Nil(t, k.BuildBatchTx(ctx, myTokenContractAddr, 10))
+	require.NotNil(t, k.getUnbatchedSendToEthereum(ctx, id))
+	require.Zero(t, k.getLastOutgoingBatchNonce(ctx))
+
+	second.veto["BeforeBatchTxCreated"] = false
+	second.calls = nil
+	batch := k.BuildBatchTx(ctx, myTokenContractAddr, 10)
+	require.NotNil(t, batch)
+	require.EqualValues(t, 1, batch.BatchNonce)
+	require.Equal(t, []string{"BeforeBatchTxCreated", "AfterBatchTxCreated"}, second.calls)
+
+	// a vetoed signer set tx doesn't take a nonce
+	first.veto["BeforeSignerSetTxCreated"] = true
+	require.Nil(t, k.CreateSignerSetTx(ctx))
+	require.Zero(t, k.GetLatestSignerSetTxNonce(ctx))
+
+	first.veto["BeforeSignerSetTxCreated"] = false
+	second.calls = nil
+	sstx := k.CreateSignerSetTx(ctx)
+	require.NotNil(t, sstx)
+	require.EqualValues(t, 1, sstx.Nonce)
+	require.Equal(t, []string{"BeforeSignerSetTxCreated", "AfterSignerSetTxCreated"}, second.calls)
+}
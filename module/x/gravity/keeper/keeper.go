@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -13,10 +12,12 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -78,10 +79,14 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	current := k.GetLatestSignerSetTxNonce(ctx)
 	next := current + 1
-	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(next))
+	k.setLatestSignerSetTxNonce(ctx, next)
 	return next
 }
 
+func (k Keeper) setLatestSignerSetTxNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // GetLatestSignerSetTxNonce returns the latest valset nonce
 func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
@@ -116,6 +121,26 @@ func (k Keeper) GetLastUnbondingBlockHeight(ctx sdk.Context) uint64 {
 	}
 }
 
+//////////////////////////////////////////
+// LastEthereumAddressChangeBlockHeight //
+//////////////////////////////////////////
+
+// setLastEthereumAddressChangeBlockHeight sets the block height of the last
+// ethereum address change of a validator
+func (k Keeper) setLastEthereumAddressChangeBlockHeight(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastEthereumAddressChangeBlockHeightKey}, sdk.Uint64ToBigEndian(height))
+}
+
+// GetLastEthereumAddressChangeBlockHeight returns the block height of the last
+// ethereum address change of a validator
+func (k Keeper) GetLastEthereumAddressChangeBlockHeight(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastEthereumAddressChangeBlockHeightKey}); len(bz) == 0 {
+		return 0
+	} else {
+		return binary.BigEndian.Uint64(bz)
+	}
+}
+
 ///////////////////////////////
 //     ETHEREUM SIGNATURES   //
 ///////////////////////////////
@@ -182,11 +207,16 @@ func (k Keeper) GetOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.Ac
 ////////////////////////
 
 // setValidatorEthereumAddress sets the ethereum address for a given validator
+// and keeps the ethereum address to validator index in sync
 func (k Keeper) setValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeValidatorEthereumAddressKey(valAddr)
 
+	if old := store.Get(key); old != nil {
+		store.Delete(types.MakeEthereumValidatorAddressKey(common.BytesToAddress(old), valAddr))
+	}
 	store.Set(key, ethAddr.Bytes())
+	store.Set(types.MakeEthereumValidatorAddressKey(ethAddr, valAddr), []byte{})
 }
 
 // GetValidatorEthereumAddress returns the eth address for a given gravity validator.
@@ -197,15 +227,15 @@ func (k Keeper) GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 	return common.BytesToAddress(store.Get(key))
 }
 
+// getValidatorsByEthereumAddress returns the validators which delegated to
+// the ethereum address
 func (k Keeper) getValidatorsByEthereumAddress(ctx sdk.Context, ethAddr common.Address) (vals []sdk.ValAddress) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	prefixKey := append([]byte{types.EthereumValidatorAddressKey}, ethAddr.Bytes()...)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if common.BytesToAddress(iter.Value()) == ethAddr {
-			valBs := bytes.TrimPrefix(iter.Key(), []byte{types.ValidatorEthereumAddressKey})
-			val := sdk.ValAddress(valBs)
-			vals = append(vals, val)
-		}
+		vals = append(vals, sdk.ValAddress(iter.Key()))
 	}
 
 	return
@@ -215,12 +245,17 @@ func (k Keeper) getValidatorsByEthereumAddress(ctx sdk.Context, ethAddr common.A
 // ETH -> ORC ADDRESS //
 ////////////////////////
 
-// setEthereumOrchestratorAddress sets the eth orch addr mapping
+// setEthereumOrchestratorAddress sets the eth orch addr mapping and keeps the
+// orchestrator to eth address index in sync
 func (k Keeper) setEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address, orch sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeEthereumOrchestratorAddressKey(ethAddr)
 
+	if old := store.Get(key); old != nil {
+		store.Delete(types.MakeOrchestratorEthereumAddressKey(old, ethAddr))
+	}
 	store.Set(key, orch.Bytes())
+	store.Set(types.MakeOrchestratorEthereumAddressKey(orch, ethAddr), []byte{})
 }
 
 // GetEthereumOrchestratorAddress gets the orch address for a given eth address
@@ -231,43 +266,54 @@ func (k Keeper) GetEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.A
 	return store.Get(key)
 }
 
+// getEthereumAddressesByOrchestrator returns the ethereum addresses which
+// delegated to the orchestrator
 func (k Keeper) getEthereumAddressesByOrchestrator(ctx sdk.Context, orch sdk.AccAddress) (ethAddrs []common.Address) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	prefixKey := append([]byte{types.OrchestratorEthereumAddressKey}, address.MustLengthPrefix(orch.Bytes())...)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if sdk.AccAddress(iter.Value()).String() == orch.String() {
-			ethBs := bytes.TrimPrefix(iter.Key(), []byte{types.EthereumOrchestratorAddressKey})
-			ethAddr := common.BytesToAddress(ethBs)
-			ethAddrs = append(ethAddrs, ethAddr)
-		}
+		ethAddrs = append(ethAddrs, common.BytesToAddress(iter.Key()))
 	}
 
 	return
 }
 
 // CreateSignerSetTx gets the current signer set from the staking keeper, increments the nonce,
-// creates the signer set tx object, emits an event and sets the signer set in state
+// creates the signer set tx object, emits an event and sets the signer set in state.
+// It returns nil if a BeforeSignerSetTxCreated hook vetoes the signer set tx.
 func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
-	nonce := k.incrementLatestSignerSetTxNonce(ctx)
 	currSignerSet := k.CurrentSignerSet(ctx)
-	newSignerSetTx := types.NewSignerSetTx(nonce, uint64(ctx.BlockHeight()), currSignerSet)
+	newSignerSetTx := types.NewSignerSetTx(k.GetLatestSignerSetTxNonce(ctx)+1, uint64(ctx.BlockHeight()), currSignerSet)
+	if err := k.BeforeSignerSetTxCreated(ctx, *newSignerSetTx); err != nil {
+		k.Logger(ctx).Info("SignerSetTx vetoed", "nonce", newSignerSetTx.Nonce, "cause", err.Error())
+		return nil
+	}
 
+	k.incrementLatestSignerSetTxNonce(ctx)
+	k.SetOutgoingTx(ctx, newSignerSetTx)
+	k.emitTypedEvent(ctx, &types.EventSignerSetTxCreated{
+		Nonce:   newSignerSetTx.Nonce,
+		Height:  newSignerSetTx.Height,
+		Signers: newSignerSetTx.Signers,
+	})
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMultisigUpdateRequest,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
 			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(nonce)),
+			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(newSignerSetTx.Nonce)),
 		),
 	)
-	k.SetOutgoingTx(ctx, newSignerSetTx)
 	k.Logger(ctx).Info(
 		"SignerSetTx created",
 		"nonce", newSignerSetTx.Nonce,
 		"height", newSignerSetTx.Height,
 		"signers", len(newSignerSetTx.Signers),
 	)
+	k.AfterSignerSetTxCreated(ctx, *newSignerSetTx)
 	return newSignerSetTx
 }
 
@@ -297,14 +343,90 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 			totalPower += p
 		}
 	}
+
 	// normalize power values
 	for i := range ethereumSigners {
 		ethereumSigners[i].Power = sdk.NewUint(ethereumSigners[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
 	}
 
+	// the validators come by decreasing power, keep the first ones up to the
+	// max number of signers, or further until they have more power than the
+	// contract requires plus a margin, so that one offline signer doesn't halt
+	// the bridge. The powers stay normalized by the total power, so the signers
+	// reaching the threshold of the contract always hold more than 2/3 of the
+	// power of all validators.
+	maxSigners := k.getSignerSetPolicy(ctx).MaxEthereumSigners
+	var includedPower uint64
+	for i, es := range ethereumSigners {
+		if maxSigners > 0 && uint64(i) >= maxSigners && includedPower > types.EthereumSignerPowerThreshold+types.EthereumSignerPowerMargin {
+			ethereumSigners = ethereumSigners[:i]
+			break
+		}
+		includedPower += es.Power
+	}
+
 	return ethereumSigners
 }
 
+// getSignerSetPolicy returns the params deciding when signer set txs are created
+func (k Keeper) getSignerSetPolicy(ctx sdk.Context) *types.Params {
+	params := &types.Params{}
+	k.paramSpace.Get(ctx, types.ParamStoreMaxEthereumSigners, &params.MaxEthereumSigners)
+	k.paramSpace.Get(ctx, types.ParamStoreSignerSetPowerDiffThreshold, &params.SignerSetPowerDiffThreshold)
+	k.paramSpace.Get(ctx, types.ParamStoreSignerSetMaxAge, &params.SignerSetMaxAge)
+	k.paramSpace.Get(ctx, types.ParamStoreSignerSetMinInterval, &params.SignerSetMinInterval)
+	return params
+}
+
+// SignerSetTxDecision decides whether a new signer set tx is created at the
+// block height of the context:
+//  1. If there are no signer set txs, create a new one.
+//  2. If a validator started unbonding since the latest signer set tx, so the
+//     unbonding validator has to provide an ethereum signature to a new signer
+//     set tx that excludes it before it completely unbonds. Otherwise it will
+//     be slashed. The min interval doesn't delay these.
+//  3. If the power change between the current signer set and the latest signer
+//     set tx is above the power diff threshold.
+//  4. If the signer set is capped and a validator moved in or out of it at the
+//     cutoff.
+//  5. If a validator of the signer set changed its ethereum address since the
+//     latest signer set tx.
+//  6. If the latest signer set tx is older than the max age.
+func (k Keeper) SignerSetTxDecision(ctx sdk.Context) *types.SignerSetTxDecision {
+	blockHeight := uint64(ctx.BlockHeight())
+	decision := &types.SignerSetTxDecision{
+		BlockHeight: blockHeight,
+		PowerDiff:   sdk.ZeroDec(),
+	}
+
+	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
+	if latestSignerSetTx == nil {
+		decision.NoSignerSetTx = true
+		decision.Create = true
+		return decision
+	}
+	decision.LatestSignerSetTxNonce = latestSignerSetTx.Nonce
+	decision.LatestSignerSetTxHeight = latestSignerSetTx.Height
+
+	policy := k.getSignerSetPolicy(ctx)
+	currentSignerSet := k.CurrentSignerSet(ctx)
+	sameMembers := currentSignerSet.HasSameMembers(latestSignerSetTx.Signers)
+	decision.PowerDiff = sdk.MustNewDecFromStr(strconv.FormatFloat(currentSignerSet.PowerDiff(latestSignerSetTx.Signers), 'f', sdk.Precision, 64))
+
+	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
+	decision.ValidatorUnbonding = lastUnbondingHeight != 0 && lastUnbondingHeight >= latestSignerSetTx.Height
+	decision.PowerChanged = decision.PowerDiff.GT(policy.SignerSetPowerDiffThreshold)
+	decision.MembersChanged = policy.MaxEthereumSigners > 0 && !sameMembers
+	lastAddressChangeHeight := k.GetLastEthereumAddressChangeBlockHeight(ctx)
+	decision.EthereumAddressChanged = lastAddressChangeHeight != 0 && lastAddressChangeHeight >= latestSignerSetTx.Height && !sameMembers
+	decision.Expired = policy.SignerSetMaxAge > 0 && blockHeight >= latestSignerSetTx.Height+policy.SignerSetMaxAge
+	decision.TooSoon = blockHeight < latestSignerSetTx.Height+policy.SignerSetMinInterval
+
+	decision.Create = decision.ValidatorUnbonding || (!decision.TooSoon &&
+		(decision.PowerChanged || decision.MembersChanged || decision.EthereumAddressChanged || decision.Expired))
+	return decision
+}
+
 // GetSignerSetTxs returns all the signer set txs from the store
 func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -315,6 +437,45 @@ func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 	return
 }
 
+// SignerSetTxIndex holds the signer set txs by increasing nonce, so that the
+// signer set of many outgoing txs is looked up without reading the store again
+type SignerSetTxIndex []*types.SignerSetTx
+
+// GetSignerSetTxIndex returns the index of the signer set txs in the store
+func (k Keeper) GetSignerSetTxIndex(ctx sdk.Context) SignerSetTxIndex {
+	// the store is iterated by decreasing nonce
+	index := SignerSetTxIndex(k.GetSignerSetTxs(ctx))
+	for i, j := 0, len(index)-1; i < j; i, j = i+1, j-1 {
+		index[i], index[j] = index[j], index[i]
+	}
+	return index
+}
+
+// RequiredSigners returns the signers of the signer set the outgoing tx is
+// signed for: the signers of the signer set tx a signer set tx replaces, which
+// the contract checks it against, or the signers of the latest signer set tx
+// created before any other outgoing tx. It returns nil if there is no such
+// signer set tx or it was pruned.
+func (index SignerSetTxIndex) RequiredSigners(otx types.OutgoingTx) types.EthereumSigners {
+	if sstx, ok := otx.(*types.SignerSetTx); ok {
+		i := sort.Search(len(index), func(i int) bool {
+			return index[i].Nonce >= sstx.Nonce
+		})
+		if i == 0 {
+			return nil
+		}
+		return index[i-1].Signers
+	}
+	// signer set txs are created at increasing heights
+	i := sort.Search(len(index), func(i int) bool {
+		return index[i].Height > otx.GetCosmosHeight()
+	})
+	if i == 0 {
+		return nil
+	}
+	return index[i-1].Signers
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -424,11 +585,17 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
+	if batchTx, ok := outgoing.(*types.BatchTx); ok {
+		k.indexBatchTx(ctx, common.HexToAddress(batchTx.TokenContract), batchTx.BatchNonce)
+	}
 }
 
 // DeleteOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
 	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
+	if len(storeIndex) > 0 && storeIndex[0] == types.BatchTxPrefixByte {
+		k.unindexBatchTx(ctx, storeIndex)
+	}
 }
 
 func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
@@ -522,6 +689,13 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 		Height:            uint64(ctx.BlockHeight()),
 	}
 
+	k.SetOutgoingTx(ctx, newContractCallTx)
+	k.emitTypedEvent(ctx, &types.EventContractCallTxCreated{
+		InvalidationScope: invalidationScope,
+		InvalidationNonce: invalidationNonce,
+		Timeout:           newContractCallTx.Timeout,
+	})
+
 	var tokenString []string
 	for _, token := range tokens {
 		tokenString = append(tokenString, token.String())
@@ -546,7 +720,6 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 			sdk.NewAttribute(types.AttributeKeyEthTxTimeout, strconv.FormatUint(params.TargetEthTxTimeout, 10)),
 		),
 	)
-	k.SetOutgoingTx(ctx, newContractCallTx)
 	k.Logger(ctx).Info(
 		"ContractCallTx created",
 		"invalidation_nonce", newContractCallTx.InvalidationNonce,
@@ -555,3 +728,17 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 	)
 	return newContractCallTx
 }
+
+// emitTypedEvent emits a gravity event defined in events.proto. The events are
+// generated protobuf messages, so they always marshal.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
+
+// EmitTypedEvent emits a gravity event defined in events.proto from outside
+// the keeper, e.g. in the begin and end blockers
+func (k Keeper) EmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	k.emitTypedEvent(ctx, event)
+}
@@ -48,6 +48,148 @@ func TestCurrentValsetNormalization(t *testing.T) {
 	}
 }
 
+func TestCurrentSignerSetMaxEthereumSigners(t *testing.T) {
+	specs := map[string]struct {
+		maxSigners uint64
+		expPowers  []uint64
+	}{
+		"no cap": {
+			maxSigners: 0,
+			expPowers:  []uint64{2147483647, 858993459, 644245094, 429496729, 214748364},
+		},
+		"cap above the threshold and the margin": {
+			maxSigners: 3,
+			expPowers:  []uint64{2147483647, 858993459, 644245094},
+		},
+		"cap above the threshold within the margin": {
+			maxSigners: 2,
+			expPowers:  []uint64{2147483647, 858993459, 644245094},
+		},
+		"cap below the threshold": {
+			maxSigners: 1,
+			expPowers:  []uint64{2147483647, 858993459, 644245094},
+		},
+		"cap above the number of validators": {
+			maxSigners: 10,
+			expPowers:  []uint64{2147483647, 858993459, 644245094, 429496729, 214748364},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+			params := input.GravityKeeper.GetParams(ctx)
+			params.MaxEthereumSigners = spec.maxSigners
+			input.GravityKeeper.setParams(ctx, params)
+
+			// validators come by decreasing power
+			powers := []int64{50, 20, 15, 10, 5}
+			var operators []MockStakingValidatorData
+			for i, power := range powers {
+				cAddr := bytes.Repeat([]byte{byte(i + 1)}, 20)
+				operators = append(operators, MockStakingValidatorData{Operator: cAddr, Power: power})
+				input.GravityKeeper.setValidatorEthereumAddress(ctx, cAddr, common.BytesToAddress(cAddr))
+			}
+			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+
+			signers := input.GravityKeeper.CurrentSignerSet(ctx)
+			assert.Equal(t, spec.expPowers, signers.GetPowers())
+			assert.Greater(t, signers.TotalPower(), types.EthereumSignerPowerThreshold+types.EthereumSignerPowerMargin)
+
+			// the signers the contract needs to reach its threshold hold more
+			// than 2/3 of the power of all validators
+			var signedPower uint64
+			var cosmosPower int64
+			for i, signer := range signers {
+				signedPower += signer.Power
+				cosmosPower += powers[i]
+				if signedPower > types.EthereumSignerPowerThreshold {
+					break
+				}
+			}
+			assert.Greater(t, signedPower, types.EthereumSignerPowerThreshold)
+			assert.Greater(t, 3*cosmosPower, 2*int64(100))
+		})
+	}
+}
+
+func TestSignerSetTxIndexRequiredSigners(t *testing.T) {
+	signers := func(addrs ...string) types.EthereumSigners {
+		var out types.EthereumSigners
+		for _, addr := range addrs {
+			out = append(out, &types.EthereumSigner{Power: 1, EthereumAddress: addr})
+		}
+		return out
+	}
+	first := &types.SignerSetTx{Nonce: 1, Height: 10, Signers: signers(EthAddrs[0].Hex(), EthAddrs[1].Hex())}
+	// the second signer set replaces a signer
+	second := &types.SignerSetTx{Nonce: 2, Height: 20, Signers: signers(EthAddrs[0].Hex(), EthAddrs[2].Hex())}
+	index := SignerSetTxIndex{first, second}
+
+	// a signer set tx is signed by the signer set it replaces
+	require.Nil(t, index.RequiredSigners(first))
+	require.Equal(t, first.Signers, index.RequiredSigners(second))
+
+	// other outgoing txs by the latest signer set when they were created
+	require.Nil(t, index.RequiredSigners(&types.BatchTx{Height: 5}))
+	require.Equal(t, first.Signers, index.RequiredSigners(&types.BatchTx{Height: 15}))
+	require.Equal(t, second.Signers, index.RequiredSigners(&types.BatchTx{Height: 20}))
+}
+
+func TestSignerSetTxDecision(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	decision := k.SignerSetTxDecision(ctx)
+	require.True(t, decision.NoSignerSetTx)
+	require.True(t, decision.Create)
+
+	sstx := k.CreateSignerSetTx(ctx)
+	params := k.GetParams(ctx)
+	params.SignerSetMaxAge = 100
+	params.SignerSetMinInterval = 10
+	k.setParams(ctx, params)
+
+	// nothing changed
+	decision = k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	require.Equal(t, sstx.Nonce, decision.LatestSignerSetTxNonce)
+	require.True(t, decision.PowerDiff.IsZero())
+	require.False(t, decision.Create)
+
+	// the latest signer set tx is too old
+	decision = k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 100))
+	require.True(t, decision.Expired)
+	require.True(t, decision.Create)
+
+	// a validator changed its ethereum address, which has to wait for the min interval
+	k.setValidatorEthereumAddress(ctx, ValAddrs[0], common.HexToAddress("0x01"))
+	k.setLastEthereumAddressChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	decision = k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	require.True(t, decision.EthereumAddressChanged)
+	require.True(t, decision.PowerChanged)
+	require.True(t, decision.TooSoon)
+	require.False(t, decision.Create)
+	decision = k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	require.False(t, decision.TooSoon)
+	require.True(t, decision.Create)
+
+	// an unbonding validator doesn't wait for the min interval
+	k.setValidatorEthereumAddress(ctx, ValAddrs[0], EthAddrs[0])
+	k.setLastUnbondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	decision = k.SignerSetTxDecision(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	require.False(t, decision.EthereumAddressChanged)
+	require.True(t, decision.ValidatorUnbonding)
+	require.True(t, decision.TooSoon)
+	require.True(t, decision.Create)
+
+	// the next block decides on the state of this one
+	res, err := k.NextSignerSetTxDecision(sdk.WrapSDKContext(ctx), &types.NextSignerSetTxDecisionRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, ctx.BlockHeight()+1, res.Decision.BlockHeight)
+	require.True(t, res.Decision.Create)
+}
+
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -504,6 +646,47 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 	})
 }
 
+func TestDelegateKeyIndexes(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		val, _     = sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+		orch, _    = sdk.AccAddressFromBech32("cosmos1g0etv93428tvxqftnmj25jn06mz6dtdasj5nz7")
+		newOrch    = sdk.AccAddress(orch[:19])
+		ethAddr    = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		newEthAddr = common.HexToAddress("0x610277F0208D342C576b991daFdCb36E36515e76")
+	)
+
+	require.Empty(t, k.getValidatorsByEthereumAddress(ctx, ethAddr))
+	require.Empty(t, k.getEthereumAddressesByOrchestrator(ctx, orch))
+
+	k.setValidatorEthereumAddress(ctx, val, ethAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, orch)
+	require.Equal(t, []sdk.ValAddress{val}, k.getValidatorsByEthereumAddress(ctx, ethAddr))
+	require.Equal(t, []common.Address{ethAddr}, k.getEthereumAddressesByOrchestrator(ctx, orch))
+
+	// overwriting a mapping moves its reverse index entry
+	k.setValidatorEthereumAddress(ctx, val, newEthAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, newOrch)
+	require.Empty(t, k.getValidatorsByEthereumAddress(ctx, ethAddr))
+	require.Equal(t, []sdk.ValAddress{val}, k.getValidatorsByEthereumAddress(ctx, newEthAddr))
+	require.Empty(t, k.getEthereumAddressesByOrchestrator(ctx, orch))
+	require.Equal(t, []common.Address{ethAddr}, k.getEthereumAddressesByOrchestrator(ctx, newOrch))
+}
+
+func BenchmarkDelegateKeyLookups(b *testing.B) {
+	input, ctx := SetupFiveValChain(b)
+	k := input.GravityKeeper
+	fillPool(input, benchmarkPoolSize)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.Len(b, k.getValidatorsByEthereumAddress(ctx, EthAddrs[i%len(EthAddrs)]), 1)
+		require.Len(b, k.getEthereumAddressesByOrchestrator(ctx, AccAddrs[i%len(AccAddrs)]), 1)
+	}
+}
+
 // TODO(levi) review/ensure coverage for:
 // PaginateOutgoingTxsByType
 // GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAddresses
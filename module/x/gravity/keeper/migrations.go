@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the secondary indexes of the delegate keys, the
+// unbatched pool and the batches from the existing state
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	// collect before writing, the store must not be written while iterating
+	var delegateKeys []*types.MsgDelegateKeys
+	iter := prefix.NewStore(store, []byte{types.EthereumOrchestratorAddressKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		delegateKeys = append(delegateKeys, &types.MsgDelegateKeys{
+			EthereumAddress:     common.BytesToAddress(iter.Key()).Hex(),
+			OrchestratorAddress: sdk.AccAddress(iter.Value()).String(),
+		})
+	}
+	iter.Close()
+	for _, keys := range delegateKeys {
+		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
+		store.Set(types.MakeOrchestratorEthereumAddressKey(orch, common.HexToAddress(keys.EthereumAddress)), []byte{})
+	}
+
+	for _, keys := range k.getDelegateKeys(ctx) {
+		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
+		store.Set(types.MakeEthereumValidatorAddressKey(common.HexToAddress(keys.EthereumAddress), val), []byte{})
+	}
+
+	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
+		k.setUnbatchedSendToEthereum(ctx, ste)
+	}
+
+	var batches []*types.BatchTx
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		batches = append(batches, otx.(*types.BatchTx))
+		return false
+	})
+	for _, batch := range batches {
+		k.indexBatchTx(ctx, common.HexToAddress(batch.TokenContract), batch.BatchNonce)
+	}
+
+	return nil
+}
+
+// Migrate2to3 sets the params added since version 1, such as
+// SlashFractionUnbondingSignerSetTx, to their defaults. The stored
+// SlashFractionSignerSetTx and SlashFractionBatch are left in place but no
+// longer read. The default
+// UnregisteredERC20Policy accepts every deposit, so the deposits of spam
+// tokens stay open until governance allows the bridged tokens and changes it.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.setMissingParams(ctx)
+	return nil
+}
+
+// setMissingParams sets the params which are not in the param store to their
+// default value
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+		}
+	}
+}
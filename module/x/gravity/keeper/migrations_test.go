@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	store := ctx.KVStore(k.storeKey)
+	var (
+		val, _   = sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+		orch, _  = sdk.AccAddressFromBech32("cosmos1g0etv93428tvxqftnmj25jn06mz6dtdasj5nz7")
+		sender   = sdk.AccAddress(orch[:19])
+		ethAddr  = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		token    = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	)
+
+	// version 1 state has no secondary indexes
+	store.Set(types.MakeValidatorEthereumAddressKey(val), ethAddr.Bytes())
+	store.Set(types.MakeEthereumOrchestratorAddressKey(ethAddr), orch.Bytes())
+	ste := types.NewSendToEthereumTx(7, token, sender, receiver, 100, 1)
+	store.Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	for _, nonce := range []uint64{3, 5} {
+		any, err := types.PackOutgoingTx(&types.BatchTx{BatchNonce: nonce, TokenContract: token.Hex()})
+		require.NoError(t, err)
+		store.Set(types.MakeOutgoingTxKey(types.MakeBatchTxKey(token, nonce)), k.cdc.MustMarshal(any))
+	}
+
+	require.Empty(t, k.getValidatorsByEthereumAddress(ctx, ethAddr))
+	require.Nil(t, k.getUnbatchedSendToEthereum(ctx, ste.Id))
+	require.Nil(t, k.getLastOutgoingBatchByTokenType(ctx, token))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	require.Equal(t, []sdk.ValAddress{val}, k.getValidatorsByEthereumAddress(ctx, ethAddr))
+	require.Equal(t, []common.Address{ethAddr}, k.getEthereumAddressesByOrchestrator(ctx, orch))
+	require.Equal(t, ste, k.getUnbatchedSendToEthereum(ctx, ste.Id))
+	var ids []uint64
+	k.iterateUnbatchedSendToEthereumsBySender(ctx, sender, func(ste *types.SendToEthereum) bool {
+		ids = append(ids, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{ste.Id}, ids)
+	require.Equal(t, uint64(5), k.getLastOutgoingBatchByTokenType(ctx, token).BatchNonce)
+}
@@ -87,10 +87,20 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		)
 	}
 
+	// a new ethereum address of a validator of the signer set needs a new signer set tx
+	if k.GetValidatorEthereumAddress(ctx, valAddr) != ethAddr {
+		k.setLastEthereumAddressChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
+
 	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
 	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
 
+	k.emitTypedEvent(ctx, &types.EventDelegateKeys{
+		Validator:       valAddr.String(),
+		Orchestrator:    orchAddr.String(),
+		EthereumAddress: ethAddr.Hex(),
+	})
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -119,6 +129,59 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	key, err := k.submitEthereumTxConfirmation(ctx, val, confirmation, msg.Confirmation.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthereumSignatureKey, string(key)),
+		),
+	)
+
+	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
+}
+
+// SubmitEthereumTxConfirmations handles MsgSubmitEthereumTxConfirmations
+func (k msgServer) SubmitEthereumTxConfirmations(c context.Context, msg *types.MsgSubmitEthereumTxConfirmations) (*types.MsgSubmitEthereumTxConfirmationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.EthereumTxConfirmationResult, len(msg.Confirmations))
+	for i, any := range msg.Confirmations {
+		confirmation, err := types.UnpackConfirmation(any)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "confirmation %d", i)
+		}
+		if _, err := k.submitEthereumTxConfirmation(ctx, val, confirmation, any.TypeUrl); err != nil {
+			return nil, sdkerrors.Wrapf(err, "confirmation %d", i)
+		}
+		results[i] = types.EthereumTxConfirmationResult{
+			StoreIndex:     confirmation.GetStoreIndex(),
+			EthereumSigner: confirmation.GetSigner().Hex(),
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSubmitEthereumTxConfirmationsResponse{Results: results}, nil
+}
+
+// submitEthereumTxConfirmation verifies the signature of the validator on the
+// outgoing tx of the confirmation and stores it, returning its store key
+func (k Keeper) submitEthereumTxConfirmation(ctx sdk.Context, val sdk.ValAddress, confirmation types.EthereumTxConfirmation, typeURL string) ([]byte, error) {
 	otx := k.GetOutgoingTx(ctx, confirmation.GetStoreIndex())
 	if otx == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find outgoing tx")
@@ -132,13 +195,13 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
+	if err := types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
 			"signature verification failed ethAddress %s gravityID %s checkpoint %s typeURL %s signature %s err %s",
 			ethAddress.Hex(),
 			gravityID,
 			hex.EncodeToString(checkpoint),
-			msg.Confirmation.TypeUrl,
+			typeURL,
 			hex.EncodeToString(confirmation.GetSignature()),
 			err,
 		))
@@ -151,15 +214,13 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 
 	key := k.SetEthereumSignature(ctx, confirmation, val)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeyEthereumSignatureKey, string(key)),
-		),
-	)
+	k.emitTypedEvent(ctx, &types.EventEthereumTxConfirmation{
+		Validator:      val.String(),
+		EthereumSigner: ethAddress.Hex(),
+		StoreIndex:     confirmation.GetStoreIndex(),
+	})
 
-	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
+	return key, nil
 }
 
 // func (k Keeper) ValidateEthereumSignature
@@ -185,12 +246,10 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 		return nil, sdkerrors.Wrap(err, "create event vote record")
 	}
 
-	// Emit the handle message event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%T", event)),
-			// TODO: maybe return something better here? is this the right string representation?
 			sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID, string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
 		),
 	)
@@ -198,6 +257,46 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 	return &types.MsgSubmitEthereumEventResponse{}, nil
 }
 
+// SubmitEthereumEvents handles MsgSubmitEthereumEvents
+func (k msgServer) SubmitEthereumEvents(c context.Context, msg *types.MsgSubmitEthereumEvents) (*types.MsgSubmitEthereumEventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// return an error if the validator isn't in the active set
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.EthereumEventVoteResult, len(msg.Events))
+	for i, any := range msg.Events {
+		event, err := types.UnpackEvent(any)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "event %d", i)
+		}
+		// Add the claim to the store, the nonce check of recordEventVote
+		// keeps the events in order
+		record, err := k.recordEventVote(ctx, event, val)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "create event vote record of event %d", i)
+		}
+		results[i] = types.EthereumEventVoteResult{
+			EventNonce: event.GetEventNonce(),
+			EventHash:  event.Hash(),
+			Votes:      uint64(len(record.Votes)),
+			Accepted:   record.Accepted,
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSubmitEthereumEventsResponse{Results: results}, nil
+}
+
 // SendToEthereum handles MsgSendToEthereum
 func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereum) (*types.MsgSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -230,6 +329,40 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 	return &types.MsgSendToEthereumResponse{Id: txID}, nil
 }
 
+// SendToEthereumMulti handles MsgSendToEthereumMulti
+func (k msgServer) SendToEthereumMulti(c context.Context, msg *types.MsgSendToEthereumMulti) (*types.MsgSendToEthereumMultiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	txIDs, err := k.createSendToEthereums(ctx, sender, msg.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, txID := range txIDs {
+		ctx.EventManager().EmitEvents([]sdk.Event{
+			sdk.NewEvent(
+				types.EventTypeBridgeWithdrawalReceived,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+				sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txID))),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+			),
+		})
+	}
+
+	return &types.MsgSendToEthereumMultiResponse{Ids: txIDs}, nil
+}
+
 // RequestBatchTx handles MsgRequestBatchTx
 func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchTx) (*types.MsgRequestBatchTxResponse, error) {
 	// TODO: limit this to only orchestrators and validators?
@@ -243,6 +376,9 @@ func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchT
 	}
 
 	batchID := k.BuildBatchTx(ctx, tokenContract, BatchTxSize)
+	if batchID == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no batch created for %s", tokenContract.Hex())
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -281,6 +417,33 @@ func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelS
 	return &types.MsgCancelSendToEthereumResponse{}, nil
 }
 
+func (k msgServer) ResolveQuarantinedDeposit(c context.Context, msg *types.MsgResolveQuarantinedDeposit) (*types.MsgResolveQuarantinedDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deposit := k.GetQuarantinedDeposit(ctx, msg.EventNonce)
+	if deposit == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no quarantined deposit with event nonce %d", msg.EventNonce)
+	}
+
+	// only the ethereum sender of the deposit can choose where it goes
+	if err := k.verifyDepositResolutionSignature(ctx, deposit, msg.CosmosReceiver, msg.EthereumSignature); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ResolveQuarantinedDeposit(ctx, msg.EventNonce, msg.CosmosReceiver); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgResolveQuarantinedDepositResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
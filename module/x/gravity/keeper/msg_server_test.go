@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
@@ -138,6 +139,59 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SendToEthereumMulti(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		sender, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		testDenom = "stake"
+		contract  = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		entries   = []types.SendToEthereumEntry{
+			{
+				EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+				Amount:            sdk.NewInt64Coin(testDenom, 1000),
+				BridgeFee:         sdk.NewInt64Coin(testDenom, 10),
+			},
+			{
+				EthereumRecipient: "0x69592e6f9d21989a043646fE8225da2600e5A0f7",
+				Amount:            sdk.NewInt64Coin(testDenom, 2000),
+				BridgeFee:         sdk.NewInt64Coin(testDenom, 20),
+			},
+		}
+	)
+
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 4000))))
+	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, contract.Hex())
+
+	msgServer := NewMsgServerImpl(gk)
+
+	// not enough balance for all entries, nothing is sent
+	tooMuch := append([]types.SendToEthereumEntry{}, entries...)
+	tooMuch = append(tooMuch, entries[1])
+	_, err := msgServer.SendToEthereumMulti(sdk.WrapSDKContext(ctx), types.NewMsgSendToEthereumMulti(sender, tooMuch))
+	require.Error(t, err)
+	require.Empty(t, gk.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, int64(4000), env.BankKeeper.GetBalance(ctx, sender, testDenom).Amount.Int64())
+
+	res, err := msgServer.SendToEthereumMulti(sdk.WrapSDKContext(ctx), types.NewMsgSendToEthereumMulti(sender, entries))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, res.Ids)
+	require.Equal(t, int64(970), env.BankKeeper.GetBalance(ctx, sender, testDenom).Amount.Int64())
+
+	// every entry is its own pool entry
+	pool := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, pool, 2)
+	for _, ste := range pool {
+		entry := entries[ste.Id-1]
+		require.Equal(t, sender.String(), ste.Sender)
+		require.Equal(t, entry.EthereumRecipient, ste.EthereumRecipient)
+		require.Equal(t, types.NewSDKIntERC20Token(entry.Amount.Amount, contract), ste.Erc20Token)
+		require.Equal(t, types.NewSDKIntERC20Token(entry.BridgeFee.Amount, contract), ste.Erc20Fee)
+	}
+}
+
 func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -300,6 +354,113 @@ func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitEthereumTxConfirmations(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+
+	gravityId := gk.getGravityID(ctx)
+	var confirmations []*types.SignerSetTxConfirmation
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		signerSetTx := gk.CreateSignerSetTx(ctx)
+		signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gravityId)), ethPrivKey)
+		require.NoError(t, err)
+		confirmations = append(confirmations, &types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr1.Hex(),
+			Signature:      signature,
+		})
+	}
+	msg := func(confirmations ...*types.SignerSetTxConfirmation) *types.MsgSubmitEthereumTxConfirmations {
+		msg := &types.MsgSubmitEthereumTxConfirmations{Signer: orcAddr1.String()}
+		for _, confirmation := range confirmations {
+			any, err := types.PackConfirmation(confirmation)
+			require.NoError(t, err)
+			msg.Confirmations = append(msg.Confirmations, any)
+		}
+		return msg
+	}
+
+	msgServer := NewMsgServerImpl(gk)
+
+	res, err := msgServer.SubmitEthereumTxConfirmations(sdk.WrapSDKContext(ctx), msg(confirmations[:2]...))
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	for i, result := range res.Results {
+		require.Equal(t, confirmations[i].GetStoreIndex(), result.StoreIndex)
+		require.Equal(t, ethAddr1.Hex(), result.EthereumSigner)
+		require.NotNil(t, gk.getEthereumSignature(ctx, result.StoreIndex, valAddr1))
+	}
+
+	// the second confirmation was already submitted
+	_, err = msgServer.SubmitEthereumTxConfirmations(sdk.WrapSDKContext(ctx), msg(confirmations[2], confirmations[1]))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "confirmation 1")
+}
+
+func TestMsgServer_SubmitEthereumEvents(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+
+	msg := func(nonces ...uint64) *types.MsgSubmitEthereumEvents {
+		msg := &types.MsgSubmitEthereumEvents{Signer: orcAddr1.String()}
+		for _, nonce := range nonces {
+			any, err := types.PackEvent(&types.SendToCosmosEvent{
+				EventNonce:     nonce,
+				TokenContract:  "test-token-contract-string",
+				Amount:         sdk.NewInt(1000),
+				EthereumSender: EthAddrs[0].String(),
+				CosmosReceiver: orcAddr1.String(),
+				EthereumHeight: 200 + nonce,
+			})
+			require.NoError(t, err)
+			msg.Events = append(msg.Events, any)
+		}
+		return msg
+	}
+
+	msgServer := NewMsgServerImpl(gk)
+
+	res, err := msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(ctx), msg(1, 2, 3))
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	for i, result := range res.Results {
+		require.EqualValues(t, i+1, result.EventNonce)
+		require.EqualValues(t, 1, result.Votes)
+		require.False(t, result.Accepted)
+		require.NotNil(t, gk.GetEthereumEventVoteRecord(ctx, result.EventNonce, result.EventHash))
+	}
+	require.EqualValues(t, 3, gk.getLastEventNonceByValidator(ctx, valAddr1))
+
+	// the vote of the third event fails the msg
+	_, err = msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(ctx), msg(4, 5, 7))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "event 2")
+}
+
 func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -343,6 +504,7 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 
 	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+	require.EqualValues(t, ctx.BlockHeight(), gk.GetLastEthereumAddressChangeBlockHeight(ctx))
 }
 
 func TestEthVerify(t *testing.T) {
@@ -8,18 +8,44 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
 )
 
-// createSendToEthereum
-// - checks a counterpart denominator exists for the given voucher type
-// - burns the voucher for transfer amount and fees
-// - persists an OutgoingTx
-// - adds the TX to the `available` TX pool via a second index
+// createSendToEthereum creates a single SendToEthereum, see createSendToEthereums
 func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
-	totalAmount := amount.Add(fee)
+	ids, err := k.createSendToEthereums(ctx, sender, []types.SendToEthereumEntry{{
+		EthereumRecipient: counterpartReceiver,
+		Amount:            amount,
+		BridgeFee:         fee,
+	}})
+	if err != nil {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+// createSendToEthereums
+// - checks a counterpart denominator exists for the voucher type of the entries
+// - runs the BeforeSendToEthereum hooks, which can veto any entry
+// - burns the voucher for the total transfer amounts and fees of all entries
+// - persists an OutgoingTx per entry
+// - adds the TXs to the `available` TX pool via a second index
+func (k Keeper) createSendToEthereums(ctx sdk.Context, sender sdk.AccAddress, entries []types.SendToEthereumEntry) ([]uint64, error) {
+	if len(entries) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "no entries")
+	}
+
+	denom := entries[0].Amount.Denom
+	totalAmount := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, entry := range entries {
+		if entry.Amount.Denom != denom || entry.BridgeFee.Denom != denom {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "all entries must be %s", denom)
+		}
+		totalAmount = totalAmount.Add(entry.Amount).Add(entry.BridgeFee)
+	}
 	totalInVouchers := sdk.Coins{totalAmount}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
@@ -27,11 +53,33 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 
 	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, totalAmount.Denom)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	// the ids are only taken once the entries are in the pool
+	lastID := k.getLastSendToEthereumID(ctx)
+	ids := make([]uint64, len(entries))
+	stes := make([]*types.SendToEthereum, len(entries))
+	for i, entry := range entries {
+		ids[i] = lastID + uint64(i) + 1
+
+		// construct outgoing tx, as part of this process we represent
+		// the token as an ERC20 token since it is preparing to go to ETH
+		// rather than the denom that is the input to this function.
+		stes[i] = &types.SendToEthereum{
+			Id:                ids[i],
+			Sender:            sender.String(),
+			EthereumRecipient: entry.EthereumRecipient,
+			Erc20Token:        types.NewSDKIntERC20Token(entry.Amount.Amount, tokenContract),
+			Erc20Fee:          types.NewSDKIntERC20Token(entry.BridgeFee.Amount, tokenContract),
+		}
+		if err := k.BeforeSendToEthereum(ctx, *stes[i]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "send to ethereum %d vetoed", ids[i])
+		}
 	}
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// If it is no a cosmos-originated asset we burn
@@ -41,23 +89,23 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		}
 	}
 
-	// get next tx id from keeper
-	nextID := k.incrementLastSendToEthereumIDKey(ctx)
-
-	// construct outgoing tx, as part of this process we represent
-	// the token as an ERC20 token since it is preparing to go to ETH
-	// rather than the denom that is the input to this function.
+	for i, entry := range entries {
+		// set the outgoing tx in the pool index
+		k.incrementLastSendToEthereumIDKey(ctx)
+		k.setUnbatchedSendToEthereum(ctx, stes[i])
 
-	// set the outgoing tx in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
-		Id:                nextID,
-		Sender:            sender.String(),
-		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
-	})
+		k.emitTypedEvent(ctx, &types.EventSendToEthereum{
+			Id:                ids[i],
+			Sender:            sender.String(),
+			EthereumRecipient: entry.EthereumRecipient,
+			TokenContract:     tokenContract.Hex(),
+			Amount:            entry.Amount.Amount,
+			BridgeFee:         entry.BridgeFee.Amount,
+		})
+		k.AfterSendToEthereum(ctx, *stes[i])
+	}
 
-	return nextID, nil
+	return ids, nil
 }
 
 // cancelSendToEthereum
@@ -67,12 +115,7 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
-	var send *types.SendToEthereum
-	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
-		if ste.Id == id {
-			send = ste
-		}
-	}
+	send := k.getUnbatchedSendToEthereum(ctx, id)
 	if send == nil {
 		// NOTE: this case will also be hit if the transaction is in a batch
 		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
@@ -82,6 +125,10 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return fmt.Errorf("can't cancel a message you didn't send")
 	}
 
+	if err := k.BeforeCancelSendToEthereum(ctx, *send); err != nil {
+		return sdkerrors.Wrapf(err, "cancel send to ethereum %d vetoed", id)
+	}
+
 	totalToRefund := send.Erc20Token.GravityCoin()
 	totalToRefund.Amount = totalToRefund.Amount.Add(send.Erc20Fee.Amount)
 	totalToRefundCoins := sdk.NewCoins(totalToRefund)
@@ -98,16 +145,69 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return sdkerrors.Wrap(err, "sending coins from module account")
 	}
 
-	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+
+	k.emitTypedEvent(ctx, &types.EventSendToEthereumCanceled{
+		Id:            send.Id,
+		Sender:        send.Sender,
+		TokenContract: send.Erc20Token.Contract,
+	})
+	k.AfterCancelSendToEthereum(ctx, *send)
 	return nil
 }
 
+// setUnbatchedSendToEthereum adds the send to ethereum to the pool, which is
+// keyed by fee, and to the id and sender indexes
 func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee)
+	store.Set(key, k.cdc.MustMarshal(ste))
+	store.Set(types.MakeSendToEthereumIDKey(ste.Id), key)
+	if sender, err := sdk.AccAddressFromBech32(ste.Sender); err == nil {
+		store.Set(types.MakeSendToEthereumSenderKey(sender, ste.Id), []byte{})
+	}
+}
+
+// deleteUnbatchedSendToEthereum removes the send to ethereum from the pool
+// and the indexes
+func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee))
+	store.Delete(types.MakeSendToEthereumIDKey(ste.Id))
+	if sender, err := sdk.AccAddressFromBech32(ste.Sender); err == nil {
+		store.Delete(types.MakeSendToEthereumSenderKey(sender, ste.Id))
+	}
+}
+
+// getUnbatchedSendToEthereum returns the send to ethereum with the id if it is
+// in the pool
+func (k Keeper) getUnbatchedSendToEthereum(ctx sdk.Context, id uint64) *types.SendToEthereum {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.MakeSendToEthereumIDKey(id))
+	if key == nil {
+		return nil
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+	var ste types.SendToEthereum
+	k.cdc.MustUnmarshal(bz, &ste)
+	return &ste
 }
 
-func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+// iterateUnbatchedSendToEthereumsBySender iterates the pool entries of the
+// sender in id order
+func (k Keeper) iterateUnbatchedSendToEthereumsBySender(ctx sdk.Context, sender sdk.AccAddress, cb func(*types.SendToEthereum) bool) {
+	prefixKey := append([]byte{types.SendToEthereumSenderKey}, address.MustLengthPrefix(sender.Bytes())...)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ste := k.getUnbatchedSendToEthereum(ctx, binary.BigEndian.Uint64(iter.Key()))
+		if ste != nil && cb(ste) {
+			break
+		}
+	}
 }
 
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
@@ -144,14 +244,20 @@ func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthe
 }
 
 func (k Keeper) incrementLastSendToEthereumIDKey(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastSendToEthereumIDKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastSendToEthereumIDKey}, bz)
+	newId := k.getLastSendToEthereumID(ctx) + 1
+	k.setLastSendToEthereumID(ctx, newId)
 	return newId
 }
+
+func (k Keeper) setLastSendToEthereumID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+// getLastSendToEthereumID returns the id of the last send to ethereum created
+func (k Keeper) getLastSendToEthereumID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSendToEthereumIDKey})
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
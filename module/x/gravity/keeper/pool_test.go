@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,3 +52,105 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestUnbatchedSendToEthereumIndexes(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos1g0etv93428tvxqftnmj25jn06mz6dtdasj5nz7")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr.Hex()).GravityCoin()}
+	)
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, allVouchers))
+	}
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, otherSender, myReceiver, 1)
+
+	bySender := func(sender sdk.AccAddress) (ids []uint64) {
+		k.iterateUnbatchedSendToEthereumsBySender(ctx, sender, func(ste *types.SendToEthereum) bool {
+			ids = append(ids, ste.Id)
+			return false
+		})
+		return ids
+	}
+	require.Equal(t, []uint64{1, 2}, bySender(mySender))
+	require.Equal(t, []uint64{3}, bySender(otherSender))
+	require.Equal(t, types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3), k.getUnbatchedSendToEthereum(ctx, 2))
+
+	// a cancel removes the entry from every index
+	require.NoError(t, k.cancelSendToEthereum(ctx, 1, mySender.String()))
+	require.Nil(t, k.getUnbatchedSendToEthereum(ctx, 1))
+	require.Equal(t, []uint64{2}, bySender(mySender))
+
+	// as does batching, and a cancelled batch restores them
+	batch := k.BuildBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	require.Nil(t, k.getUnbatchedSendToEthereum(ctx, 2))
+	require.Empty(t, bySender(mySender))
+	require.Empty(t, bySender(otherSender))
+
+	k.CancelBatchTx(ctx, myTokenContractAddr, batch.BatchNonce)
+	require.Equal(t, []uint64{2}, bySender(mySender))
+	require.Equal(t, []uint64{3}, bySender(otherSender))
+
+	res, err := k.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{SenderAddress: otherSender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.SendToEthereums, 1)
+	require.Equal(t, uint64(3), res.SendToEthereums[0].Id)
+}
+
+const benchmarkPoolSize = 100000
+
+// fillPool adds n send to ethereums of 1000 senders to the pool, bypassing
+// the bank so the pool can be large
+func fillPool(input TestInput, n int) []*types.SendToEthereum {
+	token := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	receiver := common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	stes := make([]*types.SendToEthereum, n)
+	for i := range stes {
+		sender := sdk.AccAddress(common.BigToAddress(big.NewInt(int64(i%1000 + 1))).Bytes())
+		stes[i] = types.NewSendToEthereumTx(uint64(i+1), token, sender, receiver, 100, uint64(i%97+1))
+		input.GravityKeeper.setUnbatchedSendToEthereum(input.Context, stes[i])
+	}
+	return stes
+}
+
+func BenchmarkCancelSendToEthereum(b *testing.B) {
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	stes := fillPool(input, benchmarkPoolSize)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ste := stes[i%len(stes)]
+		require.NoError(b, input.GravityKeeper.cancelSendToEthereum(ctx, ste.Id, ste.Sender))
+
+		b.StopTimer()
+		input.GravityKeeper.setUnbatchedSendToEthereum(ctx, ste)
+		b.StartTimer()
+	}
+}
+
+func BenchmarkUnbatchedSendToEthereumsBySender(b *testing.B) {
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	stes := fillPool(input, benchmarkPoolSize)
+	sender, _ := sdk.AccAddressFromBech32(stes[0].Sender)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var found int
+		input.GravityKeeper.iterateUnbatchedSendToEthereumsBySender(ctx, sender, func(*types.SendToEthereum) bool {
+			found++
+			return false
+		})
+		require.Equal(b, benchmarkPoolSize/1000, found)
+	}
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// GetQuarantinedDeposit returns the quarantined deposit of the event nonce,
+// or nil if there is none
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, eventNonce uint64) *types.QuarantinedDeposit {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeQuarantinedDepositKey(eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.QuarantinedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+func (k Keeper) setQuarantinedDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.MakeQuarantinedDepositKey(deposit.Event.EventNonce), k.cdc.MustMarshal(deposit))
+}
+
+func (k Keeper) deleteQuarantinedDeposit(ctx sdk.Context, eventNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeQuarantinedDepositKey(eventNonce))
+}
+
+// IterateQuarantinedDeposits iterates through the quarantined deposits by
+// event nonce, which is also the order they were quarantined in
+func (k Keeper) IterateQuarantinedDeposits(ctx sdk.Context, cb func(*types.QuarantinedDeposit) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.QuarantinedDepositKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		if cb(&deposit) {
+			break
+		}
+	}
+}
+
+// quarantineDeposit records a deposit which failed to credit its cosmos
+// receiver. The tokens are in the bridge contract, so the escrow balance of
+// balance delta accounting tokens is updated as for any deposit. A deposit of
+// them without a received amount is quarantined without any amount, so it
+// never credits more than reached the bridge contract.
+func (k Keeper) quarantineDeposit(ctx sdk.Context, event *types.SendToCosmosEvent, reason error) {
+	amount, _ := k.sendToCosmosAmount(ctx, event)
+	deposit := &types.QuarantinedDeposit{
+		Event:  event,
+		Amount: amount,
+		Reason: reason.Error(),
+		Height: uint64(ctx.BlockHeight()),
+	}
+	k.setQuarantinedDeposit(ctx, deposit)
+
+	k.emitTypedEvent(ctx, &types.EventDepositQuarantined{
+		EventNonce:     event.EventNonce,
+		EthereumSender: event.EthereumSender,
+		CosmosReceiver: event.CosmosReceiver,
+		TokenContract:  event.TokenContract,
+		Amount:         deposit.Amount,
+		Reason:         deposit.Reason,
+	})
+}
+
+// ResolveQuarantinedDeposit credits a quarantined deposit to the cosmos
+// receiver, or sends it back to its ethereum sender if the cosmos receiver is
+// empty. The deposit stays quarantined if crediting fails again.
+func (k Keeper) ResolveQuarantinedDeposit(ctx sdk.Context, eventNonce uint64, cosmosReceiver string) error {
+	deposit := k.GetQuarantinedDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no quarantined deposit with event nonce %d", eventNonce)
+	}
+
+	if cosmosReceiver == "" {
+		k.refundQuarantinedDeposit(ctx, deposit)
+		return nil
+	}
+
+	if err := k.creditDeposit(ctx, deposit.Event.TokenContract, cosmosReceiver, deposit.Amount); err != nil {
+		return sdkerrors.Wrapf(err, "credit quarantined deposit %d", eventNonce)
+	}
+	k.deleteQuarantinedDeposit(ctx, eventNonce)
+
+	event := *deposit.Event
+	event.CosmosReceiver = cosmosReceiver
+	k.AfterSendToCosmosEvent(ctx, event)
+
+	k.emitTypedEvent(ctx, &types.EventQuarantinedDepositResolved{
+		EventNonce:     eventNonce,
+		CosmosReceiver: cosmosReceiver,
+	})
+	return nil
+}
+
+// refundQuarantinedDeposit sends a quarantined deposit back to its ethereum
+// sender
+func (k Keeper) refundQuarantinedDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) {
+	id := k.refundDeposit(ctx, deposit.Event, deposit.Amount)
+	k.deleteQuarantinedDeposit(ctx, deposit.Event.EventNonce)
+
+	k.emitTypedEvent(ctx, &types.EventQuarantinedDepositResolved{
+		EventNonce:       deposit.Event.EventNonce,
+		SendToEthereumId: id,
+	})
+}
+
+// refundDeposit adds a send to ethereum of the deposit back to its ethereum
+// sender to the pool and returns its id. Neither vouchers were minted nor
+// cosmos originated coins unlocked for the deposit, so no coins are burnt or
+// locked for the send either. It is sent by the module account so nobody can
+// cancel it, and without bridge fee. Refunds can't be vetoed, only the
+// AfterSendToEthereum hooks are called. A deposit worth nothing isn't refunded
+// and 0 is returned.
+func (k Keeper) refundDeposit(ctx sdk.Context, event *types.SendToCosmosEvent, amount sdk.Int) uint64 {
+	if !amount.IsPositive() {
+		return 0
+	}
+	sender := authtypes.NewModuleAddress(types.ModuleName)
+	tokenContract := common.HexToAddress(event.TokenContract)
+	recipient := common.HexToAddress(event.EthereumSender)
+
+	id := k.incrementLastSendToEthereumIDKey(ctx)
+	ste := &types.SendToEthereum{
+		Id:                id,
+		Sender:            sender.String(),
+		EthereumRecipient: recipient.Hex(),
+		Erc20Token:        types.NewSDKIntERC20Token(amount, tokenContract),
+		Erc20Fee:          types.NewSDKIntERC20Token(sdk.ZeroInt(), tokenContract),
+	}
+	k.setUnbatchedSendToEthereum(ctx, ste)
+
+	k.emitTypedEvent(ctx, &types.EventSendToEthereum{
+		Id:                id,
+		Sender:            sender.String(),
+		EthereumRecipient: recipient.Hex(),
+		TokenContract:     tokenContract.Hex(),
+		Amount:            amount,
+		BridgeFee:         sdk.ZeroInt(),
+	})
+	k.AfterSendToEthereum(ctx, *ste)
+	return id
+}
+
+// RefundExpiredQuarantinedDeposits sends the deposits quarantined for longer
+// than the refund delay back to their ethereum senders. The deposits of
+// ethereum originated tokens which aren't allowed are dropped instead, so
+// spam tokens don't make the validators sign refund batches; their senders
+// can still resolve them.
+func (k Keeper) RefundExpiredQuarantinedDeposits(ctx sdk.Context) {
+	var delay uint64
+	k.paramSpace.Get(ctx, types.ParamStoreDepositQuarantineRefundDelay, &delay)
+	if delay == 0 {
+		return
+	}
+
+	var expired []*types.QuarantinedDeposit
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		// deposits are quarantined in event nonce order
+		if deposit.Height+delay > uint64(ctx.BlockHeight()) {
+			return true
+		}
+		expired = append(expired, deposit)
+		return false
+	})
+	for _, deposit := range expired {
+		if k.quarantinedDepositAllowed(ctx, deposit) {
+			k.refundQuarantinedDeposit(ctx, deposit)
+			continue
+		}
+		k.deleteQuarantinedDeposit(ctx, deposit.Event.EventNonce)
+		k.emitTypedEvent(ctx, &types.EventDepositRejected{
+			EventNonce:     deposit.Event.EventNonce,
+			EthereumSender: deposit.Event.EthereumSender,
+			CosmosReceiver: deposit.Event.CosmosReceiver,
+			TokenContract:  deposit.Event.TokenContract,
+			Amount:         deposit.Amount,
+		})
+	}
+}
+
+// quarantinedDepositAllowed returns whether the token of a quarantined deposit
+// is cosmos originated or its deposits are allowed
+func (k Keeper) quarantinedDepositAllowed(ctx sdk.Context, deposit *types.QuarantinedDeposit) bool {
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, deposit.Event.TokenContract); isCosmosOriginated {
+		return true
+	}
+	return k.erc20DepositAllowed(ctx, common.HexToAddress(deposit.Event.TokenContract))
+}
+
+// verifyDepositResolutionSignature returns an error if the signature isn't the
+// one of the ethereum sender of the deposit over the resolution on this bridge
+func (k Keeper) verifyDepositResolutionSignature(ctx sdk.Context, deposit *types.QuarantinedDeposit, cosmosReceiver string, signature []byte) error {
+	signMsgBz := k.cdc.MustMarshal(&types.DepositResolutionSignMsg{
+		EventNonce:            deposit.Event.EventNonce,
+		CosmosReceiver:        cosmosReceiver,
+		GravityId:             k.getGravityID(ctx),
+		BridgeEthereumAddress: k.getBridgeContractAddress(ctx),
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	if err := types.ValidateEthereumSignature(hash, signature, common.HexToAddress(deposit.Event.EthereumSender)); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "invalid signature of ethereum sender %s: %s", deposit.Event.EthereumSender, err)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// observeDeposit has every validator vote for a deposit and tallies it
+func observeDeposit(t *testing.T, k Keeper, ctx sdk.Context, event *types.SendToCosmosEvent) {
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+	record := &types.EthereumEventVoteRecord{Event: any}
+	for _, val := range ValAddrs {
+		record.Votes = append(record.Votes, val.String())
+	}
+	k.setEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash(), record)
+	k.TallyEventVoteRecords(ctx)
+	require.EqualValues(t, event.EventNonce, k.GetLastObservedEventNonce(ctx))
+}
+
+func badDeposit(nonce uint64, sender string) *types.SendToCosmosEvent {
+	return &types.SendToCosmosEvent{
+		EventNonce:     nonce,
+		TokenContract:  TokenContractAddrs[0],
+		EthereumSender: sender,
+		CosmosReceiver: "cosmos1invalid",
+		EthereumHeight: 10,
+		Amount:         sdk.NewInt(1000),
+	}
+}
+
+func TestQuarantineDeposit(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	_, denom := k.ERC20ToDenomLookup(ctx, TokenContractAddrs[0])
+
+	// a deposit to an invalid cosmos receiver is quarantined
+	observeDeposit(t, k, ctx, badDeposit(1, EthAddrs[0].Hex()))
+	deposit := k.GetQuarantinedDeposit(ctx, 1)
+	require.NotNil(t, deposit)
+	require.Equal(t, sdk.NewInt(1000), deposit.Amount)
+	require.EqualValues(t, ctx.BlockHeight(), deposit.Height)
+	require.NotEmpty(t, deposit.Reason)
+	require.True(t, input.BankKeeper.GetSupply(ctx, denom).IsZero())
+
+	res, err := k.QuarantinedDeposits(sdk.WrapSDKContext(ctx), &types.QuarantinedDepositsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.QuarantinedDeposit{deposit}, res.Deposits)
+
+	// an invalid receiver leaves it quarantined
+	require.Error(t, k.ResolveQuarantinedDeposit(ctx, 1, "cosmos1invalid"))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 1))
+
+	// it is credited to the new receiver
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, 1, AccAddrs[1].String()))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 1))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount)
+
+	// a resolved deposit can't be resolved again
+	require.Error(t, k.ResolveQuarantinedDeposit(ctx, 1, AccAddrs[1].String()))
+}
+
+func TestRefundQuarantinedDeposit(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	observeDeposit(t, k, ctx, badDeposit(1, EthAddrs[0].Hex()))
+
+	// it is sent back to its ethereum sender without bridge fee
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, 1, ""))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 1))
+
+	var sends []*types.SendToEthereum
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, EthAddrs[0].Hex(), sends[0].EthereumRecipient)
+	require.Equal(t, sdk.NewInt(1000), sends[0].Erc20Token.Amount)
+	require.Equal(t, common.HexToAddress(TokenContractAddrs[0]).Hex(), sends[0].Erc20Token.Contract)
+	require.True(t, sends[0].Erc20Fee.Amount.IsZero())
+
+	// nobody can cancel it
+	require.Error(t, k.cancelSendToEthereum(ctx, sends[0].Id, sends[0].Sender))
+
+	// it is batched before the sends paying a bridge fee
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+	vouchers := sdk.NewCoins(types.NewERC20Token(1000, tokenContract.Hex()).GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[1], vouchers))
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, AccAddrs[1], EthAddrs[1], 3, 2)
+
+	batch := k.BuildBatchTx(ctx, tokenContract, 2)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, sends[0].Id, batch.Transactions[0].Id)
+	require.Equal(t, sdk.NewInt(3), batch.Transactions[1].Erc20Fee.Amount)
+}
+
+func TestRefundExpiredQuarantinedDeposits(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.DepositQuarantineRefundDelay = 10
+	k.setParams(ctx, params)
+
+	observeDeposit(t, k, ctx, badDeposit(1, EthAddrs[0].Hex()))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	observeDeposit(t, k, ctx, badDeposit(2, EthAddrs[1].Hex()))
+
+	k.RefundExpiredQuarantinedDeposits(ctx.WithBlockHeight(ctx.BlockHeight() + 4))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 1))
+
+	// only the first deposit has been quarantined for the delay
+	k.RefundExpiredQuarantinedDeposits(ctx.WithBlockHeight(ctx.BlockHeight() + 5))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 1))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 2))
+
+	// deposits are never refunded without delay
+	params.DepositQuarantineRefundDelay = 0
+	k.setParams(ctx, params)
+	k.RefundExpiredQuarantinedDeposits(ctx.WithBlockHeight(ctx.BlockHeight() + 100))
+	require.NotNil(t, k.GetQuarantinedDeposit(ctx, 2))
+}
+
+func TestMsgServer_ResolveQuarantinedDeposit(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+
+	ethPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	observeDeposit(t, k, ctx, badDeposit(1, sender.Hex()))
+
+	params := k.GetParams(ctx)
+	signOn := func(receiver, gravityID, bridgeAddress string) []byte {
+		signMsgBz := input.Marshaler.MustMarshal(&types.DepositResolutionSignMsg{
+			EventNonce:            1,
+			CosmosReceiver:        receiver,
+			GravityId:             gravityID,
+			BridgeEthereumAddress: bridgeAddress,
+		})
+		sig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), ethPrivKey)
+		require.NoError(t, err)
+		return sig
+	}
+	sign := func(receiver string) []byte {
+		return signOn(receiver, params.GravityId, params.BridgeEthereumAddress)
+	}
+
+	// the signature must be the ethereum sender's over the same receiver
+	msg := types.NewMsgResolveQuarantinedDeposit(1, AccAddrs[1].String(), sign(AccAddrs[2].String()), AccAddrs[3])
+	_, err = msgServer.ResolveQuarantinedDeposit(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// and for this bridge
+	msg.EthereumSignature = signOn(AccAddrs[1].String(), "other-gravity", params.BridgeEthereumAddress)
+	_, err = msgServer.ResolveQuarantinedDeposit(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	msg.EthereumSignature = signOn(AccAddrs[1].String(), params.GravityId, EthAddrs[0].Hex())
+	_, err = msgServer.ResolveQuarantinedDeposit(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	msg.EthereumSignature = sign(AccAddrs[1].String())
+	_, err = msgServer.ResolveQuarantinedDeposit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 1))
+	_, denom := k.ERC20ToDenomLookup(ctx, TokenContractAddrs[0])
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount)
+}
+
+func TestBatchRefundsAndPaidSends(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		observeDeposit(t, k, ctx, badDeposit(nonce, EthAddrs[0].Hex()))
+		require.NoError(t, k.ResolveQuarantinedDeposit(ctx, nonce, ""))
+	}
+	vouchers := sdk.NewCoins(types.NewERC20Token(10000, tokenContract.Hex()).GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[1], vouchers))
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, AccAddrs[1], EthAddrs[1], 4, 3, 2)
+
+	// refunds take at most half of a batch, its fees are the ones of the
+	// sends it takes
+	require.Equal(t, sdk.NewInt(7), k.GetBatchFeesByTokenType(ctx, tokenContract, 4))
+	batch := k.BuildBatchTx(ctx, tokenContract, 4)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 4)
+	var fees []sdk.Int
+	for _, ste := range batch.Transactions {
+		fees = append(fees, ste.Erc20Fee.Amount)
+	}
+	require.Equal(t, []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(4), sdk.NewInt(3)}, fees)
+
+	// the remaining refund is batched with the remaining send
+	require.Equal(t, sdk.NewInt(2), k.GetBatchFeesByTokenType(ctx, tokenContract, 4))
+	batch = k.BuildBatchTx(ctx, tokenContract, 4)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.True(t, batch.Transactions[0].Erc20Fee.Amount.IsZero())
+
+	// a batch of refunds only pays nothing, it isn't created while another
+	// batch of the token is pending
+	observeDeposit(t, k, ctx, badDeposit(4, EthAddrs[0].Hex()))
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, 4, ""))
+	require.True(t, k.GetBatchFeesByTokenType(ctx, tokenContract, 4).IsZero())
+	require.Nil(t, k.BuildBatchTx(ctx, tokenContract, 4))
+}
+
+func TestFeelessBatchesOneAtATime(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	for i, tokenContract := range TokenContractAddrs[:2] {
+		deposit := badDeposit(uint64(i+1), EthAddrs[0].Hex())
+		deposit.TokenContract = tokenContract
+		observeDeposit(t, k, ctx, deposit)
+		require.NoError(t, k.ResolveQuarantinedDeposit(ctx, deposit.EventNonce, ""))
+	}
+
+	// the refunds of the second token wait for the batch of the first one
+	batch := k.BuildBatchTx(ctx, common.HexToAddress(TokenContractAddrs[0]), 4)
+	require.NotNil(t, batch)
+	require.Nil(t, k.BuildBatchTx(ctx, common.HexToAddress(TokenContractAddrs[1]), 4))
+
+	k.CancelBatchTx(ctx, common.HexToAddress(TokenContractAddrs[0]), batch.BatchNonce)
+	require.NotNil(t, k.BuildBatchTx(ctx, common.HexToAddress(TokenContractAddrs[1]), 4))
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// GetOutgoingTxSigningInfo returns the outgoing tx signing info of a
+// validator, or a new one if it has none yet
+func (k Keeper) GetOutgoingTxSigningInfo(ctx sdk.Context, val sdk.ValAddress) types.OutgoingTxSigningInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeOutgoingTxSigningInfoKey(val))
+	if bz == nil {
+		return types.OutgoingTxSigningInfo{ValidatorAddress: val.String()}
+	}
+	var info types.OutgoingTxSigningInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info
+}
+
+func (k Keeper) setOutgoingTxSigningInfo(ctx sdk.Context, val sdk.ValAddress, info types.OutgoingTxSigningInfo) {
+	ctx.KVStore(k.storeKey).Set(types.MakeOutgoingTxSigningInfoKey(val), k.cdc.MustMarshal(&info))
+}
+
+// getMissedOutgoingTxBit returns true if the validator missed the outgoing tx
+// at the index of the signing window
+func (k Keeper) getMissedOutgoingTxBit(ctx sdk.Context, val sdk.ValAddress, index uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeMissedOutgoingTxBitKey(val, index))
+}
+
+// setMissedOutgoingTxBit stores whether the validator missed the outgoing tx
+// at the index of the signing window, only the missed ones are stored
+func (k Keeper) setMissedOutgoingTxBit(ctx sdk.Context, val sdk.ValAddress, index uint64, missed bool) {
+	if missed {
+		ctx.KVStore(k.storeKey).Set(types.MakeMissedOutgoingTxBitKey(val, index), []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.MakeMissedOutgoingTxBitKey(val, index))
+	}
+}
+
+// IterateOutgoingTxSigningInfos iterates through the outgoing tx signing infos
+// of the validators
+func (k Keeper) IterateOutgoingTxSigningInfos(ctx sdk.Context, cb func(sdk.ValAddress, types.OutgoingTxSigningInfo) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxSigningInfoKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.OutgoingTxSigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		if cb(iter.Key(), info) {
+			break
+		}
+	}
+}
+
+// iterateMissedOutgoingTxBits iterates through the indexes of the outgoing
+// txs the validator missed in the signing window
+func (k Keeper) iterateMissedOutgoingTxBits(ctx sdk.Context, val sdk.ValAddress, cb func(uint64) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeMissedOutgoingTxBitPrefix(val)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			break
+		}
+	}
+}
+
+// clearMissedOutgoingTxBits deletes the missed outgoing txs of the validator
+func (k Keeper) clearMissedOutgoingTxBits(ctx sdk.Context, val sdk.ValAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeMissedOutgoingTxBitPrefix(val))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getOutgoingTxSigningParams returns the params of the outgoing tx signing window
+func (k Keeper) getOutgoingTxSigningParams(ctx sdk.Context) *types.Params {
+	params := &types.Params{}
+	k.paramSpace.Get(ctx, types.ParamStoreOutgoingTxSigningWindow, &params.OutgoingTxSigningWindow)
+	k.paramSpace.Get(ctx, types.ParamStoreMinSignedOutgoingTxsPerWindow, &params.MinSignedOutgoingTxsPerWindow)
+	k.paramSpace.Get(ctx, types.ParamStoreOutgoingTxDowntimeJailDuration, &params.OutgoingTxDowntimeJailDuration)
+	k.paramSpace.Get(ctx, types.ParamStoreSlashFractionOutgoingTxDowntime, &params.SlashFractionOutgoingTxDowntime)
+	return params
+}
+
+// HandleOutgoingTxSignature records whether a bonded validator signed an
+// outgoing tx it had to sign, in the same way the slashing module records
+// whether it signed a block. A validator which missed more outgoing txs than
+// the min signed per window allows over the last window is slashed and jailed
+// for the downtime jail duration, and its window starts over.
+func (k Keeper) HandleOutgoingTxSignature(ctx sdk.Context, val stakingtypes.Validator, cons sdk.ConsAddress, signed bool, otx types.OutgoingTx) {
+	params := k.getOutgoingTxSigningParams(ctx)
+	valAddr := val.GetOperator()
+	info := k.GetOutgoingTxSigningInfo(ctx, valAddr)
+
+	// the index of the outgoing tx in the window, where the previous bit is
+	// the one from the previous window
+	index := info.IndexOffset % params.OutgoingTxSigningWindow
+	info.IndexOffset++
+
+	previous := k.getMissedOutgoingTxBit(ctx, valAddr, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		k.setMissedOutgoingTxBit(ctx, valAddr, index, true)
+		info.MissedOutgoingTxsCounter++
+	case previous && !missed:
+		k.setMissedOutgoingTxBit(ctx, valAddr, index, false)
+		info.MissedOutgoingTxsCounter--
+	}
+
+	minSigned := uint64(params.MinSignedOutgoingTxsPerWindow.MulInt64(int64(params.OutgoingTxSigningWindow)).RoundInt64())
+	maxMissed := params.OutgoingTxSigningWindow - minSigned
+
+	// only validators which had to sign a whole window can be slashed
+	if info.IndexOffset >= params.OutgoingTxSigningWindow && info.MissedOutgoingTxsCounter > maxMissed && !val.IsJailed() {
+		k.Logger(ctx).Info(
+			"slashing and jailing validator for missing outgoing tx signatures",
+			"validator", valAddr.String(),
+			"missed", info.MissedOutgoingTxsCounter,
+			"threshold", minSigned,
+		)
+
+		k.SlashValidator(ctx, val, cons, params.SlashFractionOutgoingTxDowntime, types.AttributeMissingBridgeSignatures, otx)
+		info.JailedUntil = ctx.BlockTime().Add(params.OutgoingTxDowntimeJailDuration)
+		k.jailUntil(ctx, cons, info.JailedUntil)
+
+		// the validator starts over with a new window once unjailed
+		info.IndexOffset = 0
+		info.MissedOutgoingTxsCounter = 0
+		k.clearMissedOutgoingTxBits(ctx, valAddr)
+	}
+
+	k.setOutgoingTxSigningInfo(ctx, valAddr, info)
+}
+
+// jailUntil keeps the slashing module from unjailing the validator before the
+// jail time, unless it is already jailed for longer
+func (k Keeper) jailUntil(ctx sdk.Context, cons sdk.ConsAddress, jailTime time.Time) {
+	signingInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, cons)
+	if found && signingInfo.JailedUntil.Before(jailTime) {
+		k.SlashingKeeper.JailUntil(ctx, cons, jailTime)
+	}
+}
+
+// SlashValidator slashes and jails a validator for not signing the outgoing tx
+// and emits both the slashing module's event and the gravity event
+func (k Keeper) SlashValidator(ctx sdk.Context, val stakingtypes.Validator, cons sdk.ConsAddress, fraction sdk.Dec, reason string, otx types.OutgoingTx) {
+	power := val.ConsensusPower(k.PowerReduction)
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), power, fraction)
+	k.StakingKeeper.Jail(ctx, cons)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			slashingtypes.EventTypeSlash,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, cons.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyJailed, cons.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyReason, reason),
+			sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventValidatorSlashed{
+		Validator:        val.GetOperator().String(),
+		ConsensusAddress: cons.String(),
+		Power:            power,
+		SlashFraction:    fraction,
+		Reason:           reason,
+		StoreIndex:       otx.GetStoreIndex(),
+	})
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestHandleOutgoingTxSignature(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// two missed outgoing txs out of four are tolerated
+	params := k.GetParams(ctx)
+	params.OutgoingTxSigningWindow = 4
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	k.setParams(ctx, params)
+
+	val, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	cons, err := val.GetConsAddr()
+	require.NoError(t, err)
+	otx := &types.BatchTx{BatchNonce: 1, TokenContract: TokenContractAddrs[0]}
+
+	for _, signed := range []bool{false, true, false} {
+		k.HandleOutgoingTxSignature(ctx, val, cons, signed, otx)
+	}
+	info := k.GetOutgoingTxSigningInfo(ctx, ValAddrs[0])
+	require.EqualValues(t, 3, info.IndexOffset)
+	require.EqualValues(t, 2, info.MissedOutgoingTxsCounter)
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// the third miss of the window jails the validator
+	k.HandleOutgoingTxSignature(ctx, val, cons, false, otx)
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+	info = k.GetOutgoingTxSigningInfo(ctx, ValAddrs[0])
+	require.Zero(t, info.IndexOffset)
+	require.Zero(t, info.MissedOutgoingTxsCounter)
+	require.Equal(t, ctx.BlockTime().Add(params.OutgoingTxDowntimeJailDuration), info.JailedUntil)
+	require.False(t, k.getMissedOutgoingTxBit(ctx, ValAddrs[0], 0))
+	signingInfo, found := input.SlashingKeeper.GetValidatorSigningInfo(ctx, cons)
+	require.True(t, found)
+	require.Equal(t, info.JailedUntil, signingInfo.JailedUntil)
+
+	res, err := k.OutgoingTxSigningInfo(sdk.WrapSDKContext(ctx), &types.OutgoingTxSigningInfoRequest{ValidatorAddress: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, info, res.SigningInfo)
+}
+
+func TestHandleOutgoingTxSignatureSlidingWindow(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.OutgoingTxSigningWindow = 3
+	params.MinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+	k.setParams(ctx, params)
+
+	val, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	cons, err := val.GetConsAddr()
+	require.NoError(t, err)
+	otx := &types.BatchTx{BatchNonce: 1, TokenContract: TokenContractAddrs[0]}
+
+	// a miss leaves the window once the validator signs its index again
+	for _, signed := range []bool{false, true, true, true, false, true} {
+		k.HandleOutgoingTxSignature(ctx, val, cons, signed, otx)
+	}
+	info := k.GetOutgoingTxSigningInfo(ctx, ValAddrs[0])
+	require.EqualValues(t, 6, info.IndexOffset)
+	require.EqualValues(t, 1, info.MissedOutgoingTxsCounter)
+	require.False(t, k.getMissedOutgoingTxBit(ctx, ValAddrs[0], 0))
+	require.True(t, k.getMissedOutgoingTxBit(ctx, ValAddrs[0], 1))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
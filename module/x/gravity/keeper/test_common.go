@@ -192,10 +192,15 @@ var (
 		TargetEthTxTimeout:                        60001,
 		AverageBlockTime:                          5000,
 		AverageEthereumBlockTime:                  15000,
-		SlashFractionSignerSetTx:                  sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:                        sdk.NewDecWithPrec(1, 2),
 		SlashFractionEthereumSignature:            sdk.NewDecWithPrec(1, 2),
 		SlashFractionConflictingEthereumSignature: sdk.NewDecWithPrec(1, 2),
+		EventVotePowerThreshold:                   types.DefaultEventVotePowerThreshold,
+		SignerSetPowerDiffThreshold:               types.DefaultSignerSetPowerDiffThreshold,
+		OutgoingTxSigningWindow:                   types.DefaultOutgoingTxSigningWindow,
+		MinSignedOutgoingTxsPerWindow:             types.DefaultMinSignedOutgoingTxsPerWindow,
+		OutgoingTxDowntimeJailDuration:            types.DefaultOutgoingTxDowntimeJailDuration,
+		SlashFractionOutgoingTxDowntime:           sdk.NewDecWithPrec(1, 2),
+		SlashFractionUnbondingSignerSetTx:         sdk.NewDecWithPrec(5, 2),
 	}
 )
 
@@ -226,8 +231,13 @@ func (input TestInput) AddBalanceToBank(ctx sdk.Context, addr sdk.AccAddress, ba
 	return fundAccount(ctx, input.BankKeeper, addr, balances)
 }
 
+// SetGravityParams sets the params of the gravity module
+func (input TestInput) SetGravityParams(ctx sdk.Context, params types.Params) {
+	input.GravityKeeper.setParams(ctx, params)
+}
+
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -276,7 +286,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -0,0 +1,29 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+// NewProposalHandler returns a handler for "Gravity" type governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ResolveQuarantinedDepositProposal:
+			return k.ResolveQuarantinedDeposit(ctx, c.EventNonce, c.CosmosReceiver)
+
+		case *types.SetERC20TokenMetadataProposal:
+			return k.SetERC20TokenMetadata(ctx, c.ERC20TokenMetadata())
+
+		case *types.ApproveCosmosERC20Proposal:
+			return k.ApproveCosmosERC20(ctx, c.Denom, c.TokenContract)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
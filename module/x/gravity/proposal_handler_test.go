@@ -0,0 +1,91 @@
+package gravity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/x/gravity"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/x/gravity/types"
+)
+
+func TestResolveQuarantinedDepositProposal(t *testing.T) {
+	var (
+		orchestratorAddr, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		myCosmosAddr, _     = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		valAddr             = sdk.ValAddress(orchestratorAddr)
+		tokenETHAddr        = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddr)
+	input.GravityKeeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchestratorAddr)
+	h := gravity.NewHandler(input.GravityKeeper)
+	ph := gravity.NewProposalHandler(input.GravityKeeper)
+
+	// the module account is blocked from receiving funds
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenETHAddr,
+		Amount:         sdk.NewInt(12),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: authtypes.NewModuleAddress(types.ModuleName).String(),
+	}
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+	_, err = h(ctx, &types.MsgSubmitEthereumEvent{Event: any, Signer: orchestratorAddr.String()})
+	require.NoError(t, err)
+	gravity.EndBlocker(ctx, input.GravityKeeper)
+	require.NotNil(t, input.GravityKeeper.GetQuarantinedDeposit(ctx, 1))
+
+	require.Error(t, ph(ctx, types.NewResolveQuarantinedDepositProposal("title", "description", 2, myCosmosAddr.String())))
+	require.Error(t, ph(ctx, govtypes.NewTextProposal("title", "description")))
+
+	require.NoError(t, ph(ctx, types.NewResolveQuarantinedDepositProposal("title", "description", 1, myCosmosAddr.String())))
+	require.Nil(t, input.GravityKeeper.GetQuarantinedDeposit(ctx, 1))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+}
+
+func TestSetERC20TokenMetadataProposal(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	ph := gravity.NewProposalHandler(input.GravityKeeper)
+	token := types.ERC20TokenMetadata{
+		TokenContract: "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		Name:          "yearn.finance",
+		Symbol:        "YFI",
+		Decimals:      18,
+		Allowed:       true,
+	}
+
+	proposal := types.NewSetERC20TokenMetadataProposal("title", "description", token)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, ph(ctx, proposal))
+	require.True(t, input.GravityKeeper.GetERC20TokenMetadata(ctx, common.HexToAddress(token.TokenContract)).Allowed)
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, types.NewERC20Token(0, common.HexToAddress(token.TokenContract).Hex()).GravityCoin().Denom)
+	require.True(t, found)
+	require.Equal(t, "YFI", metadata.Display)
+
+	token.TokenContract = "0xinvalid"
+	require.Error(t, types.NewSetERC20TokenMetadataProposal("title", "description", token).ValidateBasic())
+}
+
+func TestApproveCosmosERC20Proposal(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	ph := gravity.NewProposalHandler(input.GravityKeeper)
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+
+	proposal := types.NewApproveCosmosERC20Proposal("title", "description", "stake", tokenContract.Hex())
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, ph(ctx, proposal))
+	require.Equal(t, &types.CosmosERC20Approval{Denom: "stake", TokenContract: tokenContract.Hex()}, input.GravityKeeper.GetCosmosERC20Approval(ctx, "stake"))
+
+	require.Error(t, types.NewApproveCosmosERC20Proposal("title", "description", "stake", "").ValidateBasic())
+	require.Error(t, types.NewApproveCosmosERC20Proposal("title", "description", "", tokenContract.Hex()).ValidateBasic())
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -21,6 +23,14 @@ const (
 
 	// GravityDenomLen is the length of the denoms generated by the gravity module
 	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + EthereumContractAddressLen
+
+	// MaxERC20Decimals is the largest number of decimals of an ERC20 token, they
+	// are an uint8
+	MaxERC20Decimals = 255
+
+	// MaxPendingERC20DeploymentsPerDenom is the number of unapproved
+	// deployments kept for a denom, older ones are pruned
+	MaxPendingERC20DeploymentsPerDenom = 10
 )
 
 // EthereumAddrLessThan migrates the Ethereum address less than function
@@ -82,6 +92,57 @@ func GravityDenomToERC20(denom string) (string, error) {
 	}
 }
 
+/////////////////////////
+// ERC20TokenMetadata  //
+/////////////////////////
+
+// ValidateBasic performs stateless checks
+func (m ERC20TokenMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(m.TokenContract) {
+		return sdkerrors.Wrapf(ErrInvalid, "token contract %s", m.TokenContract)
+	}
+	if m.Decimals > MaxERC20Decimals {
+		return sdkerrors.Wrapf(ErrInvalid, "ERC20 decimals %d", m.Decimals)
+	}
+	return nil
+}
+
+// BankMetadata returns the bank denom metadata of the vouchers of the token.
+// The symbol is the display unit with the ERC20 decimals as exponent if it is
+// a valid denom, otherwise the vouchers are displayed in their base denom. It
+// returns false if the metadata isn't valid, e.g. for a token without name.
+func (m ERC20TokenMetadata) BankMetadata() (banktypes.Metadata, bool) {
+	base := NewERC20Token(0, m.TokenContract).GravityCoin().Denom
+	md := banktypes.Metadata{
+		Description: fmt.Sprintf("%s bridged from the ethereum ERC20 token %s", m.Name, m.TokenContract),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: base, Exponent: 0}},
+		Base:        base,
+		Display:     base,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+	}
+	if m.Decimals > 0 && m.Symbol != base && sdk.ValidateDenom(m.Symbol) == nil {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: m.Symbol, Exponent: uint32(m.Decimals)})
+		md.Display = m.Symbol
+	}
+	return md, md.Validate() == nil
+}
+
+/////////////////////////
+// CosmosERC20Approval //
+/////////////////////////
+
+// ValidateBasic performs stateless checks
+func (a CosmosERC20Approval) ValidateBasic() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if !common.IsHexAddress(a.TokenContract) {
+		return sdkerrors.Wrapf(ErrInvalid, "token contract %s", a.TokenContract)
+	}
+	return nil
+}
+
 func NewSendToEthereumTx(id uint64, tokenContract common.Address, sender sdk.AccAddress, recipient common.Address, amount, feeAmount uint64) *SendToEthereum {
 	return &SendToEthereum{
 		Id:                id,
@@ -40,6 +40,7 @@ func (stce *SendToCosmosEvent) Hash() tmbytes.HexBytes {
 			common.Hex2Bytes(stce.EthereumSender),
 			rcv.Bytes(),
 			sdk.Uint64ToBigEndian(stce.EthereumHeight),
+			optionalIntsBytes(stce.ReceivedAmount, stce.EscrowBalance),
 		},
 		[]byte{},
 	)
@@ -54,6 +55,7 @@ func (bee *BatchExecutedEvent) Hash() tmbytes.HexBytes {
 			sdk.Uint64ToBigEndian(bee.EventNonce),
 			sdk.Uint64ToBigEndian(bee.BatchNonce),
 			sdk.Uint64ToBigEndian(bee.EthereumHeight),
+			optionalIntsBytes(bee.EscrowBalance),
 		},
 		[]byte{},
 	)
@@ -106,6 +108,35 @@ func (sse *SignerSetTxExecutedEvent) Hash() tmbytes.HexBytes {
 	return hash[:]
 }
 
+// intBytes returns the big endian bytes of an optional sdk.Int field. Unset and
+// zero values both map to no bytes, so the hash of events that do not report
+// the field is unchanged.
+// optionalIntsBytes encodes optional ints for an event hash. Every int is
+// encoded at a fixed width after a byte telling whether it is set, so that no
+// two sets of values share an encoding. Events without any of them set hash as
+// they did before the ints were added.
+func optionalIntsBytes(ints ...sdk.Int) []byte {
+	var out []byte
+	for _, i := range ints {
+		if !i.IsNil() {
+			out = []byte{}
+			break
+		}
+	}
+	if out == nil {
+		return nil
+	}
+	for _, i := range ints {
+		if i.IsNil() {
+			out = append(out, 0)
+			continue
+		}
+		out = append(out, 1)
+		out = append(out, common.LeftPadBytes(i.BigInt().Bytes(), 32)...)
+	}
+	return out
+}
+
 //////////////
 // Validate //
 //////////////
@@ -120,6 +151,12 @@ func (stce *SendToCosmosEvent) Validate() error {
 	if stce.Amount.IsNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
 	}
+	if !stce.ReceivedAmount.IsNil() && (stce.ReceivedAmount.IsNegative() || stce.ReceivedAmount.GT(stce.Amount)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "received amount must be positive and not exceed the amount")
+	}
+	if !stce.EscrowBalance.IsNil() && stce.EscrowBalance.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "escrow balance must be positive")
+	}
 	if !common.IsHexAddress(stce.EthereumSender) {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
 	}
@@ -136,6 +173,9 @@ func (bee *BatchExecutedEvent) Validate() error {
 	if !common.IsHexAddress(bee.TokenContract) {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
 	}
+	if !bee.EscrowBalance.IsNil() && bee.EscrowBalance.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "escrow balance must be positive")
+	}
 	return nil
 }
 
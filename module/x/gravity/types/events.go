@@ -1,5 +1,11 @@
 package types
 
+// Legacy string events. The gravity state transitions are reported by the
+// typed events defined in events.proto, these events are still emitted
+// alongside them so that existing indexers keep working.
+//
+// Deprecated: use the typed events instead, the legacy events will be removed
+// in a future release.
 const (
 	EventTypeObservation              = "observation"
 	EventTypeOutgoingBatch            = "outgoing_batch"
@@ -30,5 +36,13 @@ const (
 	AttributeKeyContractCallTokens            = "contract_call_tokens"
 	AttributeKeyContractCallFees              = "contract_call_fees"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
-	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+)
+
+// Reasons for slashing a validator which are set on the slashing module's
+// slash event and on EventValidatorSlashed.
+const (
+	AttributeMissingBridgeBatchSig        = "missing_bridge_batch_signature"
+	AttributeMissingBridgeSignerSetSig    = "missing_bridge_signer_set_signature"
+	AttributeMissingBridgeContractCallSig = "missing_bridge_contract_call_signature"
+	AttributeMissingBridgeSignatures      = "missing_bridge_signatures"
 )
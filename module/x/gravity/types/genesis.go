@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"time"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -10,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -50,10 +52,12 @@ var (
 	// ParamsStoreKeyAverageEthereumBlockTime stores the signed blocks window
 	ParamsStoreKeyAverageEthereumBlockTime = []byte("AverageEthereumBlockTime")
 
-	// ParamsStoreSlashFractionSignerSetTx stores the slash fraction valset
+	// ParamsStoreSlashFractionSignerSetTx stored the slash fraction valset.
+	// Deprecated: the param is no longer part of the param set.
 	ParamsStoreSlashFractionSignerSetTx = []byte("SlashFractionSignerSetTx")
 
-	// ParamsStoreSlashFractionBatch stores the slash fraction Batch
+	// ParamsStoreSlashFractionBatch stored the slash fraction Batch.
+	// Deprecated: the param is no longer part of the param set.
 	ParamsStoreSlashFractionBatch = []byte("SlashFractionBatch")
 
 	// ParamsStoreSlashFractionEthereumSignature stores the slash fraction ethereum siganture
@@ -65,6 +69,83 @@ var (
 	//  ParamStoreUnbondSlashingSignerSetTxsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingSignerSetTxsWindow = []byte("UnbondSlashingSignerSetTxsWindow")
 
+	// ParamStoreBalanceDeltaAccountingTokens stores the balance delta accounting tokens
+	ParamStoreBalanceDeltaAccountingTokens = []byte("BalanceDeltaAccountingTokens")
+
+	// ParamStoreEventVotePowerThreshold stores the event vote power threshold
+	ParamStoreEventVotePowerThreshold = []byte("EventVotePowerThreshold")
+
+	// ParamStoreEventTypePowerThresholds stores the power thresholds of event types
+	ParamStoreEventTypePowerThresholds = []byte("EventTypePowerThresholds")
+
+	// ParamStoreDepositPowerThresholds stores the power thresholds of large deposits
+	ParamStoreDepositPowerThresholds = []byte("DepositPowerThresholds")
+
+	// ParamStoreMaxEthereumSigners stores the maximum number of signers of a signer set
+	ParamStoreMaxEthereumSigners = []byte("MaxEthereumSigners")
+
+	// ParamStoreSignerSetPowerDiffThreshold stores the power change which triggers a signer set tx
+	ParamStoreSignerSetPowerDiffThreshold = []byte("SignerSetPowerDiffThreshold")
+
+	// ParamStoreSignerSetMaxAge stores the number of blocks after which a signer set tx is created
+	ParamStoreSignerSetMaxAge = []byte("SignerSetMaxAge")
+
+	// ParamStoreSignerSetMinInterval stores the minimum number of blocks between signer set txs
+	ParamStoreSignerSetMinInterval = []byte("SignerSetMinInterval")
+
+	// ParamStoreOutgoingTxSigningWindow stores the number of outgoing txs over which missed signatures are counted
+	ParamStoreOutgoingTxSigningWindow = []byte("OutgoingTxSigningWindow")
+
+	// ParamStoreMinSignedOutgoingTxsPerWindow stores the fraction of the outgoing txs of the window a validator has to sign
+	ParamStoreMinSignedOutgoingTxsPerWindow = []byte("MinSignedOutgoingTxsPerWindow")
+
+	// ParamStoreOutgoingTxDowntimeJailDuration stores how long a validator which signed too few outgoing txs is jailed for
+	ParamStoreOutgoingTxDowntimeJailDuration = []byte("OutgoingTxDowntimeJailDuration")
+
+	// ParamStoreSlashFractionOutgoingTxDowntime stores the slash fraction of a validator which signed too few outgoing txs
+	ParamStoreSlashFractionOutgoingTxDowntime = []byte("SlashFractionOutgoingTxDowntime")
+
+	// ParamStoreDepositQuarantineRefundDelay stores the number of blocks after which a quarantined deposit is refunded
+	ParamStoreDepositQuarantineRefundDelay = []byte("DepositQuarantineRefundDelay")
+
+	// ParamStoreUnregisteredERC20Policy stores what happens to the deposits of tokens which aren't allowed in the token registry
+	ParamStoreUnregisteredERC20Policy = []byte("UnregisteredERC20Policy")
+
+	// ParamStoreSlashFractionUnbondingSignerSetTx stores the slash fraction of an unbonding validator which didn't sign a signer set tx
+	ParamStoreSlashFractionUnbondingSignerSetTx = []byte("SlashFractionUnbondingSignerSetTx")
+
+	// DefaultEventVotePowerThreshold is the event vote power threshold of new chains
+	DefaultEventVotePowerThreshold = sdk.NewDecWithPrec(66, 2)
+
+	// DefaultSignerSetPowerDiffThreshold is the power change of 5% which
+	// triggers a signer set tx
+	DefaultSignerSetPowerDiffThreshold = sdk.NewDecWithPrec(5, 2)
+
+	// DefaultOutgoingTxSigningWindow is the number of outgoing txs over which
+	// missed signatures are counted
+	DefaultOutgoingTxSigningWindow uint64 = 100
+
+	// DefaultMinSignedOutgoingTxsPerWindow is the fraction of the outgoing txs
+	// of the window a validator has to sign
+	DefaultMinSignedOutgoingTxsPerWindow = sdk.NewDecWithPrec(5, 1)
+
+	// DefaultOutgoingTxDowntimeJailDuration is how long a validator which
+	// signed too few outgoing txs is jailed for
+	DefaultOutgoingTxDowntimeJailDuration = 10 * time.Minute
+
+	// DefaultSlashFractionOutgoingTxDowntime is the slash fraction of a
+	// validator which signed too few outgoing txs
+	DefaultSlashFractionOutgoingTxDowntime = sdk.NewDecWithPrec(1, 3)
+
+	// DefaultDepositQuarantineRefundDelay is the number of blocks after which
+	// an unresolved quarantined deposit is refunded, a week of 5s blocks
+	DefaultDepositQuarantineRefundDelay uint64 = 120960
+
+	// DefaultSlashFractionUnbondingSignerSetTx is the slash fraction of an
+	// unbonding validator which didn't sign a signer set tx, ten times the
+	// one of bonded validators
+	DefaultSlashFractionUnbondingSignerSetTx = sdk.NewDecWithPrec(1, 2)
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -90,8 +171,35 @@ func (gs *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	return nil
 }
 
-func EventVoteRecordPowerThreshold(totalPower sdk.Int) sdk.Int {
-	return sdk.NewInt(66).Mul(totalPower).Quo(sdk.NewInt(100))
+// EventVoteRecordPowerThreshold returns the power which has to vote for an
+// event with the threshold for it to be observed
+func EventVoteRecordPowerThreshold(totalPower sdk.Int, threshold sdk.Dec) sdk.Int {
+	return threshold.MulInt(totalPower).TruncateInt()
+}
+
+// EventVotePowerThresholdOf returns the fraction of the last total power which
+// has to vote for the event: the threshold of its type, raised by the highest
+// deposit threshold whose amount a deposit reaches
+func (p Params) EventVotePowerThresholdOf(event EthereumEvent) sdk.Dec {
+	threshold := p.EventVotePowerThreshold
+	eventType := proto.MessageName(event)
+	for _, t := range p.EventTypePowerThresholds {
+		if t.EventType == eventType {
+			threshold = t.Threshold
+		}
+	}
+
+	deposit, ok := event.(*SendToCosmosEvent)
+	if !ok {
+		return threshold
+	}
+	for _, t := range p.DepositPowerThresholds {
+		if common.HexToAddress(t.TokenContract) == common.HexToAddress(deposit.TokenContract) &&
+			deposit.Amount.GTE(t.Amount) && t.Threshold.GT(threshold) {
+			threshold = t.Threshold
+		}
+	}
+	return threshold
 }
 
 // ValidateBasic validates genesis state by looping through the params and
@@ -100,6 +208,49 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	for _, item := range s.LastEventNoncesByValidator {
+		if _, err := sdk.ValAddressFromBech32(item.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "last event nonce by validator")
+		}
+	}
+	for _, item := range s.Erc20EscrowBalances {
+		if !common.IsHexAddress(item.TokenContract) {
+			return sdkerrors.Wrapf(ErrInvalid, "escrow balance token contract: %s", item.TokenContract)
+		}
+		if item.Balance.IsNil() || item.Balance.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "escrow balance of %s: %s", item.TokenContract, item.Balance)
+		}
+	}
+	for _, info := range s.OutgoingTxSigningInfos {
+		if _, err := sdk.ValAddressFromBech32(info.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "outgoing tx signing info")
+		}
+	}
+	for _, missed := range s.MissedOutgoingTxs {
+		if _, err := sdk.ValAddressFromBech32(missed.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "missed outgoing txs")
+		}
+	}
+	for _, deposit := range s.QuarantinedDeposits {
+		if deposit.Event == nil {
+			return sdkerrors.Wrap(ErrInvalid, "quarantined deposit without event")
+		}
+	}
+	for _, token := range s.Erc20Tokens {
+		if err := token.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "erc20 token metadata")
+		}
+	}
+	for _, approval := range s.CosmosErc20Approvals {
+		if err := approval.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "cosmos erc20 approval")
+		}
+	}
+	for _, deployment := range s.PendingErc20Deployments {
+		if err := deployment.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "pending erc20 deployment")
+		}
+	}
 	return nil
 }
 
@@ -122,11 +273,24 @@ func DefaultParams() *Params {
 		TargetEthTxTimeout:                        43200000,
 		AverageBlockTime:                          5000,
 		AverageEthereumBlockTime:                  15000,
-		SlashFractionSignerSetTx:                  sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:                        sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionEthereumSignature:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingEthereumSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		UnbondSlashingSignerSetTxsWindow:          10000,
+		BalanceDeltaAccountingTokens:              []string{},
+		EventVotePowerThreshold:                   DefaultEventVotePowerThreshold,
+		EventTypePowerThresholds:                  []EventTypePowerThreshold{},
+		DepositPowerThresholds:                    []DepositPowerThreshold{},
+		MaxEthereumSigners:                        0,
+		SignerSetPowerDiffThreshold:               DefaultSignerSetPowerDiffThreshold,
+		SignerSetMaxAge:                           0,
+		SignerSetMinInterval:                      0,
+		OutgoingTxSigningWindow:                   DefaultOutgoingTxSigningWindow,
+		MinSignedOutgoingTxsPerWindow:             DefaultMinSignedOutgoingTxsPerWindow,
+		OutgoingTxDowntimeJailDuration:            DefaultOutgoingTxDowntimeJailDuration,
+		SlashFractionOutgoingTxDowntime:           DefaultSlashFractionOutgoingTxDowntime,
+		DepositQuarantineRefundDelay:              DefaultDepositQuarantineRefundDelay,
+		UnregisteredErc20Policy:                   UnregisteredERC20PolicyAccept,
+		SlashFractionUnbondingSignerSetTx:         DefaultSlashFractionUnbondingSignerSetTx,
 	}
 }
 
@@ -162,12 +326,6 @@ func (p Params) ValidateBasic() error {
 	if err := validateEthereumSignaturesWindow(p.EthereumSignaturesWindow); err != nil {
 		return sdkerrors.Wrap(err, "signed blocks window")
 	}
-	if err := validateSlashFractionSignerSetTx(p.SlashFractionSignerSetTx); err != nil {
-		return sdkerrors.Wrap(err, "slash fraction signersettx")
-	}
-	if err := validateSlashFractionBatch(p.SlashFractionBatch); err != nil {
-		return sdkerrors.Wrap(err, "slash fraction batch tx")
-	}
 	if err := validateSlashFractionEthereumSignature(p.SlashFractionEthereumSignature); err != nil {
 		return sdkerrors.Wrap(err, "slash fraction ethereum signature")
 	}
@@ -177,6 +335,51 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateBalanceDeltaAccountingTokens(p.BalanceDeltaAccountingTokens); err != nil {
+		return sdkerrors.Wrap(err, "balance delta accounting tokens")
+	}
+	if err := validateEventVotePowerThreshold(p.EventVotePowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "event vote power threshold")
+	}
+	if err := validateEventTypePowerThresholds(p.EventTypePowerThresholds); err != nil {
+		return sdkerrors.Wrap(err, "event type power thresholds")
+	}
+	if err := validateDepositPowerThresholds(p.DepositPowerThresholds); err != nil {
+		return sdkerrors.Wrap(err, "deposit power thresholds")
+	}
+	if err := validateMaxEthereumSigners(p.MaxEthereumSigners); err != nil {
+		return sdkerrors.Wrap(err, "max ethereum signers")
+	}
+	if err := validateSignerSetPowerDiffThreshold(p.SignerSetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "signer set power diff threshold")
+	}
+	if err := validateSignerSetMaxAge(p.SignerSetMaxAge); err != nil {
+		return sdkerrors.Wrap(err, "signer set max age")
+	}
+	if err := validateSignerSetMinInterval(p.SignerSetMinInterval); err != nil {
+		return sdkerrors.Wrap(err, "signer set min interval")
+	}
+	if err := validateOutgoingTxSigningWindow(p.OutgoingTxSigningWindow); err != nil {
+		return sdkerrors.Wrap(err, "outgoing tx signing window")
+	}
+	if err := validateMinSignedOutgoingTxsPerWindow(p.MinSignedOutgoingTxsPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min signed outgoing txs per window")
+	}
+	if err := validateOutgoingTxDowntimeJailDuration(p.OutgoingTxDowntimeJailDuration); err != nil {
+		return sdkerrors.Wrap(err, "outgoing tx downtime jail duration")
+	}
+	if err := validateSlashFractionOutgoingTxDowntime(p.SlashFractionOutgoingTxDowntime); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction outgoing tx downtime")
+	}
+	if err := validateDepositQuarantineRefundDelay(p.DepositQuarantineRefundDelay); err != nil {
+		return sdkerrors.Wrap(err, "deposit quarantine refund delay")
+	}
+	if err := validateUnregisteredERC20Policy(p.UnregisteredErc20Policy); err != nil {
+		return sdkerrors.Wrap(err, "unregistered erc20 policy")
+	}
+	if err := validateSlashFractionUnbondingSignerSetTx(p.SlashFractionUnbondingSignerSetTx); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction unbonding signer set tx")
+	}
 
 	return nil
 }
@@ -200,11 +403,24 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreKeyAverageBlockTime, &p.AverageBlockTime, validateAverageBlockTime),
 		paramtypes.NewParamSetPair(ParamsStoreKeyTargetEthTxTimeout, &p.TargetEthTxTimeout, validateTargetEthTxTimeout),
 		paramtypes.NewParamSetPair(ParamsStoreKeyAverageEthereumBlockTime, &p.AverageEthereumBlockTime, validateAverageEthereumBlockTime),
-		paramtypes.NewParamSetPair(ParamsStoreSlashFractionSignerSetTx, &p.SlashFractionSignerSetTx, validateSlashFractionSignerSetTx),
-		paramtypes.NewParamSetPair(ParamsStoreSlashFractionBatch, &p.SlashFractionBatch, validateSlashFractionBatch),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionEthereumSignature, &p.SlashFractionEthereumSignature, validateSlashFractionEthereumSignature),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingEthereumSignature, &p.SlashFractionConflictingEthereumSignature, validateSlashFractionConflictingEthereumSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
+		paramtypes.NewParamSetPair(ParamStoreBalanceDeltaAccountingTokens, &p.BalanceDeltaAccountingTokens, validateBalanceDeltaAccountingTokens),
+		paramtypes.NewParamSetPair(ParamStoreEventVotePowerThreshold, &p.EventVotePowerThreshold, validateEventVotePowerThreshold),
+		paramtypes.NewParamSetPair(ParamStoreEventTypePowerThresholds, &p.EventTypePowerThresholds, validateEventTypePowerThresholds),
+		paramtypes.NewParamSetPair(ParamStoreDepositPowerThresholds, &p.DepositPowerThresholds, validateDepositPowerThresholds),
+		paramtypes.NewParamSetPair(ParamStoreMaxEthereumSigners, &p.MaxEthereumSigners, validateMaxEthereumSigners),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetPowerDiffThreshold, &p.SignerSetPowerDiffThreshold, validateSignerSetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetMaxAge, &p.SignerSetMaxAge, validateSignerSetMaxAge),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetMinInterval, &p.SignerSetMinInterval, validateSignerSetMinInterval),
+		paramtypes.NewParamSetPair(ParamStoreOutgoingTxSigningWindow, &p.OutgoingTxSigningWindow, validateOutgoingTxSigningWindow),
+		paramtypes.NewParamSetPair(ParamStoreMinSignedOutgoingTxsPerWindow, &p.MinSignedOutgoingTxsPerWindow, validateMinSignedOutgoingTxsPerWindow),
+		paramtypes.NewParamSetPair(ParamStoreOutgoingTxDowntimeJailDuration, &p.OutgoingTxDowntimeJailDuration, validateOutgoingTxDowntimeJailDuration),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionOutgoingTxDowntime, &p.SlashFractionOutgoingTxDowntime, validateSlashFractionOutgoingTxDowntime),
+		paramtypes.NewParamSetPair(ParamStoreDepositQuarantineRefundDelay, &p.DepositQuarantineRefundDelay, validateDepositQuarantineRefundDelay),
+		paramtypes.NewParamSetPair(ParamStoreUnregisteredERC20Policy, &p.UnregisteredErc20Policy, validateUnregisteredERC20Policy),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionUnbondingSignerSetTx, &p.SlashFractionUnbondingSignerSetTx, validateSlashFractionUnbondingSignerSetTx),
 	}
 }
 
@@ -305,15 +521,15 @@ func validateUnbondSlashingSignerSetTxsWindow(i interface{}) error {
 	return nil
 }
 
-func validateSlashFractionSignerSetTx(i interface{}) error {
+func validateSignedBatchesWindow(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+	if _, ok := i.(uint64); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 	return nil
 }
 
-func validateSignedBatchesWindow(i interface{}) error {
+func validateEthereumSignaturesWindow(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
 	if _, ok := i.(uint64); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -321,15 +537,15 @@ func validateSignedBatchesWindow(i interface{}) error {
 	return nil
 }
 
-func validateEthereumSignaturesWindow(i interface{}) error {
+func validateSlashFractionEthereumSignature(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(uint64); !ok {
+	if _, ok := i.(sdk.Dec); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 	return nil
 }
 
-func validateSlashFractionBatch(i interface{}) error {
+func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
 	if _, ok := i.(sdk.Dec); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -337,22 +553,182 @@ func validateSlashFractionBatch(i interface{}) error {
 	return nil
 }
 
-func validateSlashFractionEthereumSignature(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+func validateBalanceDeltaAccountingTokens(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	seen := make(map[common.Address]bool, len(v))
+	for _, token := range v {
+		if !common.IsHexAddress(token) {
+			return fmt.Errorf("not an ethereum address: %s", token)
+		}
+		if seen[common.HexToAddress(token)] {
+			return fmt.Errorf("duplicate token contract: %s", token)
+		}
+		seen[common.HexToAddress(token)] = true
+	}
 	return nil
 }
 
-func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+// validatePowerThreshold checks that a power threshold is more than half of
+// the power and at most all of it
+func validatePowerThreshold(v sdk.Dec) error {
+	if v.IsNil() || v.LTE(sdk.NewDecWithPrec(5, 1)) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("power threshold must be more than 0.5 and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateEventVotePowerThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validatePowerThreshold(v)
+}
+
+func validateEventTypePowerThresholds(i interface{}) error {
+	v, ok := i.([]EventTypePowerThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	eventType := reflect.TypeOf((*EthereumEvent)(nil)).Elem()
+	seen := make(map[string]bool, len(v))
+	for _, t := range v {
+		if typ := proto.MessageType(t.EventType); typ == nil || !typ.Implements(eventType) {
+			return fmt.Errorf("not an ethereum event type: %s", t.EventType)
+		}
+		if seen[t.EventType] {
+			return fmt.Errorf("duplicate event type: %s", t.EventType)
+		}
+		seen[t.EventType] = true
+		if err := validatePowerThreshold(t.Threshold); err != nil {
+			return sdkerrors.Wrap(err, t.EventType)
+		}
+	}
+	return nil
+}
+
+func validateDepositPowerThresholds(i interface{}) error {
+	v, ok := i.([]DepositPowerThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, t := range v {
+		if !common.IsHexAddress(t.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", t.TokenContract)
+		}
+		if t.Amount.IsNil() || !t.Amount.IsPositive() {
+			return fmt.Errorf("deposit amount must be positive: %s", t.Amount)
+		}
+		key := common.HexToAddress(t.TokenContract).Hex() + t.Amount.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate deposit threshold: %s %s", t.TokenContract, t.Amount)
+		}
+		seen[key] = true
+		if err := validatePowerThreshold(t.Threshold); err != nil {
+			return sdkerrors.Wrapf(err, "%s %s", t.TokenContract, t.Amount)
+		}
+	}
+	return nil
+}
+
+func validateMaxEthereumSigners(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignerSetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("power diff threshold must be positive and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateSignerSetMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignerSetMinInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOutgoingTxSigningWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("outgoing tx signing window must be positive")
+	}
+	return nil
+}
+
+func validateMinSignedOutgoingTxsPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed outgoing txs per window must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateOutgoingTxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("outgoing tx downtime jail duration must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateSlashFractionOutgoingTxDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateDepositQuarantineRefundDelay(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 	return nil
 }
 
+func validateUnregisteredERC20Policy(i interface{}) error {
+	v, ok := i.(UnregisteredERC20Policy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := UnregisteredERC20Policy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown unregistered erc20 policy: %d", v)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -369,3 +745,14 @@ func byteArrayToFixByteArray(b []byte) (out [32]byte, err error) {
 	copy(out[:], b)
 	return out, nil
 }
+
+func validateSlashFractionUnbondingSignerSetTx(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
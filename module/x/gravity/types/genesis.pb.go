@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UnregisteredERC20Policy is what happens to the deposits of ethereum
+// originated ERC20 tokens which aren't allowed in the token registry
+type UnregisteredERC20Policy int32
+
+const (
+	// UNREGISTERED_ERC20_POLICY_ACCEPT credits the deposits of every token
+	UnregisteredERC20PolicyAccept UnregisteredERC20Policy = 0
+	// UNREGISTERED_ERC20_POLICY_REJECT sends the deposits back to their
+	// ethereum sender
+	UnregisteredERC20PolicyReject UnregisteredERC20Policy = 1
+	// UNREGISTERED_ERC20_POLICY_QUARANTINE quarantines the deposits until the
+	// token is allowed or they are resolved
+	UnregisteredERC20PolicyQuarantine UnregisteredERC20Policy = 2
+)
+
+var UnregisteredERC20Policy_name = map[int32]string{
+	0: "UNREGISTERED_ERC20_POLICY_ACCEPT",
+	1: "UNREGISTERED_ERC20_POLICY_REJECT",
+	2: "UNREGISTERED_ERC20_POLICY_QUARANTINE",
+}
+
+var UnregisteredERC20Policy_value = map[string]int32{
+	"UNREGISTERED_ERC20_POLICY_ACCEPT":     0,
+	"UNREGISTERED_ERC20_POLICY_REJECT":     1,
+	"UNREGISTERED_ERC20_POLICY_QUARANTINE": 2,
+}
+
+func (x UnregisteredERC20Policy) String() string {
+	return proto.EnumName(UnregisteredERC20Policy_name, int32(x))
+}
+
+func (UnregisteredERC20Policy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{0}
+}
+
 // Params represent the Gravity genesis and store parameters
 // gravity_id:
 // a random 32 byte value to prevent signature reuse, for example if the
@@ -85,7 +124,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //
 // The slashing fractions for the various gravity related slashing conditions.
 // The first three refer to not submitting a particular message, the third for
-// submitting a different ethereum_signature for the same Ethereum event
+// submitting a different ethereum_signature for the same Ethereum event.
+// slash_fraction_signer_set_tx and slash_fraction_batch are no longer used,
+// unbonding validators are slashed by slash_fraction_unbonding_signer_set_tx
+// and the outgoing txs bonded validators miss count towards the outgoing tx
+// signing window instead.
 type Params struct {
 	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -97,12 +140,69 @@ type Params struct {
 	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
 	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
 	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// TODO: slash fraction for contract call txs too
-	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
-	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	// slash_fraction_signer_set_tx is no longer read, unbonding validators are
+	// slashed by slash_fraction_unbonding_signer_set_tx. Deprecated: the field is
+	// kept so that existing genesis files still decode.
+	SlashFractionSignerSetTx github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"` // Deprecated: Do not use.
+	// slash_fraction_batch is no longer read, missed batches are counted over
+	// the outgoing tx signing window. Deprecated: the field is kept so that
+	// existing genesis files still decode.
+	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"` // Deprecated: Do not use.
 	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
 	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
 	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
+	// balance_delta_accounting_tokens lists the ERC20 contracts (e.g.
+	// fee-on-transfer or rebasing tokens) for which vouchers are minted for the
+	// amount actually received by the bridge contract and batches are bounded by
+	// the escrow balance reported by orchestrators
+	BalanceDeltaAccountingTokens []string `protobuf:"bytes,18,rep,name=balance_delta_accounting_tokens,json=balanceDeltaAccountingTokens,proto3" json:"balance_delta_accounting_tokens,omitempty"`
+	// event_vote_power_threshold is the fraction of the last total power which
+	// has to vote for an event for it to be observed
+	EventVotePowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=event_vote_power_threshold,json=eventVotePowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"event_vote_power_threshold"`
+	// event_type_power_thresholds override the event vote power threshold for
+	// types of events
+	EventTypePowerThresholds []EventTypePowerThreshold `protobuf:"bytes,20,rep,name=event_type_power_thresholds,json=eventTypePowerThresholds,proto3" json:"event_type_power_thresholds"`
+	// deposit_power_thresholds require a higher threshold for deposits of at
+	// least an amount of a token
+	DepositPowerThresholds []DepositPowerThreshold `protobuf:"bytes,21,rep,name=deposit_power_thresholds,json=depositPowerThresholds,proto3" json:"deposit_power_thresholds"`
+	// max_ethereum_signers caps the number of signers of a signer set to the
+	// validators with the most power, as long as they have more power than the
+	// contract requires. Zero means no cap.
+	MaxEthereumSigners uint64 `protobuf:"varint,22,opt,name=max_ethereum_signers,json=maxEthereumSigners,proto3" json:"max_ethereum_signers,omitempty"`
+	// signer_set_power_diff_threshold is the power change between the current
+	// signer set and the latest signer set tx above which a new one is created
+	SignerSetPowerDiffThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=signer_set_power_diff_threshold,json=signerSetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_power_diff_threshold"`
+	// signer_set_max_age is the number of blocks after which a new signer set
+	// tx is created even without changes. Zero means never.
+	SignerSetMaxAge uint64 `protobuf:"varint,24,opt,name=signer_set_max_age,json=signerSetMaxAge,proto3" json:"signer_set_max_age,omitempty"`
+	// signer_set_min_interval is the minimum number of blocks between signer
+	// set txs, except the ones created for unbonding validators
+	SignerSetMinInterval uint64 `protobuf:"varint,25,opt,name=signer_set_min_interval,json=signerSetMinInterval,proto3" json:"signer_set_min_interval,omitempty"`
+	// outgoing_tx_signing_window is the number of outgoing txs over which the
+	// missed signatures of a validator are counted
+	OutgoingTxSigningWindow uint64 `protobuf:"varint,26,opt,name=outgoing_tx_signing_window,json=outgoingTxSigningWindow,proto3" json:"outgoing_tx_signing_window,omitempty"`
+	// min_signed_outgoing_txs_per_window is the fraction of the outgoing txs of
+	// the window a validator has to sign not to be slashed and jailed
+	MinSignedOutgoingTxsPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=min_signed_outgoing_txs_per_window,json=minSignedOutgoingTxsPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_outgoing_txs_per_window"`
+	// outgoing_tx_downtime_jail_duration is how long a validator which signed
+	// too few outgoing txs is jailed for
+	OutgoingTxDowntimeJailDuration time.Duration `protobuf:"bytes,28,opt,name=outgoing_tx_downtime_jail_duration,json=outgoingTxDowntimeJailDuration,proto3,stdduration" json:"outgoing_tx_downtime_jail_duration"`
+	// slash_fraction_outgoing_tx_downtime is the slash fraction of a validator
+	// which signed too few outgoing txs
+	SlashFractionOutgoingTxDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=slash_fraction_outgoing_tx_downtime,json=slashFractionOutgoingTxDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_outgoing_tx_downtime"`
+	// deposit_quarantine_refund_delay is the number of blocks after which an
+	// unresolved quarantined deposit is sent back to its ethereum sender. Zero
+	// means never.
+	DepositQuarantineRefundDelay uint64 `protobuf:"varint,30,opt,name=deposit_quarantine_refund_delay,json=depositQuarantineRefundDelay,proto3" json:"deposit_quarantine_refund_delay,omitempty"`
+	// unregistered_erc20_policy is what happens to the deposits of ethereum
+	// originated ERC20 tokens which aren't allowed in the token registry
+	UnregisteredErc20Policy UnregisteredERC20Policy `protobuf:"varint,31,opt,name=unregistered_erc20_policy,json=unregisteredErc20Policy,proto3,enum=gravity.v1.UnregisteredERC20Policy" json:"unregistered_erc20_policy,omitempty"`
+	// slash_fraction_unbonding_signer_set_tx is the slash fraction of an
+	// unbonding validator which didn't sign a signer set tx created during the
+	// unbond slashing window. It defaults higher than the one of bonded
+	// validators, since the stake of a validator which has unbonded can't be
+	// slashed for it anymore.
+	SlashFractionUnbondingSignerSetTx github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,32,opt,name=slash_fraction_unbonding_signer_set_tx,json=slashFractionUnbondingSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_unbonding_signer_set_tx"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -215,25 +315,210 @@ func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetBalanceDeltaAccountingTokens() []string {
+	if m != nil {
+		return m.BalanceDeltaAccountingTokens
+	}
+	return nil
+}
+
+func (m *Params) GetEventTypePowerThresholds() []EventTypePowerThreshold {
+	if m != nil {
+		return m.EventTypePowerThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetDepositPowerThresholds() []DepositPowerThreshold {
+	if m != nil {
+		return m.DepositPowerThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetMaxEthereumSigners() uint64 {
+	if m != nil {
+		return m.MaxEthereumSigners
+	}
+	return 0
+}
+
+func (m *Params) GetSignerSetMaxAge() uint64 {
+	if m != nil {
+		return m.SignerSetMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetSignerSetMinInterval() uint64 {
+	if m != nil {
+		return m.SignerSetMinInterval
+	}
+	return 0
+}
+
+func (m *Params) GetOutgoingTxSigningWindow() uint64 {
+	if m != nil {
+		return m.OutgoingTxSigningWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOutgoingTxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.OutgoingTxDowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDepositQuarantineRefundDelay() uint64 {
+	if m != nil {
+		return m.DepositQuarantineRefundDelay
+	}
+	return 0
+}
+
+func (m *Params) GetUnregisteredErc20Policy() UnregisteredERC20Policy {
+	if m != nil {
+		return m.UnregisteredErc20Policy
+	}
+	return UnregisteredERC20PolicyAccept
+}
+
+// EventTypePowerThreshold is the power threshold of the events of a type,
+// given by the full name of the event message, e.g.
+// "gravity.v1.SignerSetTxExecutedEvent"
+type EventTypePowerThreshold struct {
+	EventType string                                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+}
+
+func (m *EventTypePowerThreshold) Reset()         { *m = EventTypePowerThreshold{} }
+func (m *EventTypePowerThreshold) String() string { return proto.CompactTextString(m) }
+func (*EventTypePowerThreshold) ProtoMessage()    {}
+func (*EventTypePowerThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *EventTypePowerThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTypePowerThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTypePowerThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTypePowerThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTypePowerThreshold.Merge(m, src)
+}
+func (m *EventTypePowerThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTypePowerThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTypePowerThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTypePowerThreshold proto.InternalMessageInfo
+
+func (m *EventTypePowerThreshold) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+// DepositPowerThreshold is the power threshold of the deposits of a token of
+// at least an amount
+type DepositPowerThreshold struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Threshold     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+}
+
+func (m *DepositPowerThreshold) Reset()         { *m = DepositPowerThreshold{} }
+func (m *DepositPowerThreshold) String() string { return proto.CompactTextString(m) }
+func (*DepositPowerThreshold) ProtoMessage()    {}
+func (*DepositPowerThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *DepositPowerThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositPowerThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositPowerThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositPowerThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositPowerThreshold.Merge(m, src)
+}
+func (m *DepositPowerThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositPowerThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositPowerThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositPowerThreshold proto.InternalMessageInfo
+
+func (m *DepositPowerThreshold) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
 type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	Params                               *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce               uint64                       `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                          []*types.Any                 `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                        []*types.Any                 `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords             []*EthereumEventVoteRecord   `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys                         []*MsgDelegateKeys           `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                        []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs           []*SendToEthereum            `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	LastOutgoingBatchNonce               uint64                       `protobuf:"varint,13,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LastSendToEthereumId                 uint64                       `protobuf:"varint,14,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	LatestSignerSetTxNonce               uint64                       `protobuf:"varint,15,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LastObservedSignerSetTx              *SignerSetTx                 `protobuf:"bytes,16,opt,name=last_observed_signer_set_tx,json=lastObservedSignerSetTx,proto3" json:"last_observed_signer_set_tx,omitempty"`
+	LastObservedEthereumHeight           *LatestEthereumBlockHeight   `protobuf:"bytes,17,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height,omitempty"`
+	LastSlashedOutgoingTxBlockHeight     uint64                       `protobuf:"varint,18,opt,name=last_slashed_outgoing_tx_block_height,json=lastSlashedOutgoingTxBlockHeight,proto3" json:"last_slashed_outgoing_tx_block_height,omitempty"`
+	LastUnbondingBlockHeight             uint64                       `protobuf:"varint,19,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	LastEthereumAddressChangeBlockHeight uint64                       `protobuf:"varint,20,opt,name=last_ethereum_address_change_block_height,json=lastEthereumAddressChangeBlockHeight,proto3" json:"last_ethereum_address_change_block_height,omitempty"`
+	LastEventNoncesByValidator           []*LastEventNonceByValidator `protobuf:"bytes,21,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator,omitempty"`
+	Erc20EscrowBalances                  []*ERC20EscrowBalance        `protobuf:"bytes,22,rep,name=erc20_escrow_balances,json=erc20EscrowBalances,proto3" json:"erc20_escrow_balances,omitempty"`
+	OutgoingTxSigningInfos               []*OutgoingTxSigningInfo     `protobuf:"bytes,23,rep,name=outgoing_tx_signing_infos,json=outgoingTxSigningInfos,proto3" json:"outgoing_tx_signing_infos,omitempty"`
+	MissedOutgoingTxs                    []*MissedOutgoingTxs         `protobuf:"bytes,24,rep,name=missed_outgoing_txs,json=missedOutgoingTxs,proto3" json:"missed_outgoing_txs,omitempty"`
+	QuarantinedDeposits                  []*QuarantinedDeposit        `protobuf:"bytes,25,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	Erc20Tokens                          []*ERC20TokenMetadata        `protobuf:"bytes,26,rep,name=erc20_tokens,json=erc20Tokens,proto3" json:"erc20_tokens,omitempty"`
+	CosmosErc20Approvals                 []*CosmosERC20Approval       `protobuf:"bytes,27,rep,name=cosmos_erc20_approvals,json=cosmosErc20Approvals,proto3" json:"cosmos_erc20_approvals,omitempty"`
+	PendingErc20Deployments              []*ERC20DeployedEvent        `protobuf:"bytes,28,rep,name=pending_erc20_deployments,json=pendingErc20Deployments,proto3" json:"pending_erc20_deployments,omitempty"`
+	// remapped_erc20_to_denoms are the previous ERC20 representations of
+	// remapped cosmos originated denoms
+	RemappedErc20ToDenoms []*ERC20ToDenom `protobuf:"bytes,29,rep,name=remapped_erc20_to_denoms,json=remappedErc20ToDenoms,proto3" json:"remapped_erc20_to_denoms,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,25 +603,144 @@ func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	return nil
 }
 
-// This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
-type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *GenesisState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
+	}
+	return 0
 }
 
-func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
-func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
-func (*ERC20ToDenom) ProtoMessage()    {}
-func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+func (m *GenesisState) GetLastSendToEthereumId() uint64 {
+	if m != nil {
+		return m.LastSendToEthereumId
+	}
+	return 0
 }
-func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+
+func (m *GenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastObservedSignerSetTx() *SignerSetTx {
+	if m != nil {
+		return m.LastObservedSignerSetTx
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeight() *LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashedOutgoingTxBlockHeight() uint64 {
+	if m != nil {
+		return m.LastSlashedOutgoingTxBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastEthereumAddressChangeBlockHeight() uint64 {
+	if m != nil {
+		return m.LastEthereumAddressChangeBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastEventNoncesByValidator() []*LastEventNonceByValidator {
+	if m != nil {
+		return m.LastEventNoncesByValidator
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20EscrowBalances() []*ERC20EscrowBalance {
+	if m != nil {
+		return m.Erc20EscrowBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutgoingTxSigningInfos() []*OutgoingTxSigningInfo {
+	if m != nil {
+		return m.OutgoingTxSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedOutgoingTxs() []*MissedOutgoingTxs {
+	if m != nil {
+		return m.MissedOutgoingTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Tokens() []*ERC20TokenMetadata {
+	if m != nil {
+		return m.Erc20Tokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCosmosErc20Approvals() []*CosmosERC20Approval {
+	if m != nil {
+		return m.CosmosErc20Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingErc20Deployments() []*ERC20DeployedEvent {
+	if m != nil {
+		return m.PendingErc20Deployments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemappedErc20ToDenoms() []*ERC20ToDenom {
+	if m != nil {
+		return m.RemappedErc20ToDenoms
+	}
+	return nil
+}
+
+// LastEventNonceByValidator records the nonce of the last event a validator
+// voted for
+type LastEventNonceByValidator struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastEventNonceByValidator) Reset()         { *m = LastEventNonceByValidator{} }
+func (m *LastEventNonceByValidator) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonceByValidator) ProtoMessage()    {}
+func (*LastEventNonceByValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *LastEventNonceByValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LastEventNonceByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LastEventNonceByValidator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -346,121 +750,580 @@ func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+func (m *LastEventNonceByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonceByValidator.Merge(m, src)
 }
-func (m *ERC20ToDenom) XXX_Size() int {
+func (m *LastEventNonceByValidator) XXX_Size() int {
 	return m.Size()
 }
-func (m *ERC20ToDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+func (m *LastEventNonceByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonceByValidator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+var xxx_messageInfo_LastEventNonceByValidator proto.InternalMessageInfo
 
-func (m *ERC20ToDenom) GetErc20() string {
+func (m *LastEventNonceByValidator) GetValidatorAddress() string {
 	if m != nil {
-		return m.Erc20
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *ERC20ToDenom) GetDenom() string {
+func (m *LastEventNonceByValidator) GetEventNonce() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.EventNonce
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+// ERC20EscrowBalance records the tracked bridge contract balance of a balance
+// delta accounting token
+type ERC20EscrowBalance struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Balance       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
-
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xb6, 0xa9, 0x1b, 0x9a, 0xb1, 0x4d, 0xca, 0xe0, 0xc0, 0xd6, 0x29, 0xae, 0x09, 0xa2, 0x0a,
-	0x88, 0xac, 0x13, 0x23, 0xf1, 0x13, 0x01, 0x6a, 0xf3, 0x03, 0x54, 0x08, 0x8a, 0xd6, 0x16, 0x48,
-	0x5c, 0x30, 0x8c, 0x77, 0x4e, 0xc6, 0x4b, 0xec, 0x99, 0x68, 0x67, 0xd6, 0xb5, 0xef, 0x78, 0x84,
-	0x3e, 0x0b, 0x8f, 0xc0, 0x55, 0x2f, 0x7b, 0x89, 0x10, 0xaa, 0x50, 0xf2, 0x22, 0x68, 0x7e, 0xd6,
-	0x5e, 0x3b, 0xe5, 0x26, 0x57, 0xeb, 0x39, 0xdf, 0xf7, 0x9d, 0xf3, 0xcd, 0x1c, 0xcf, 0x19, 0x14,
-	0xf0, 0x94, 0x4e, 0x12, 0x3d, 0xeb, 0x4c, 0xf6, 0x3b, 0x1c, 0x04, 0xa8, 0x44, 0x85, 0xe7, 0xa9,
-	0xd4, 0x12, 0x23, 0x8f, 0x84, 0x93, 0xfd, 0x66, 0x83, 0x4b, 0x2e, 0x6d, 0xb8, 0x63, 0x7e, 0x39,
-	0x46, 0x73, 0x49, 0xeb, 0xc9, 0x0e, 0xd9, 0x2c, 0x20, 0x63, 0xc5, 0x7d, 0xca, 0xe6, 0x1d, 0x2e,
-	0x25, 0x1f, 0x41, 0xc7, 0xae, 0x06, 0xd9, 0x69, 0x87, 0x0a, 0xaf, 0xd8, 0xfe, 0xf3, 0x16, 0x5a,
-	0xfb, 0x81, 0xa6, 0x74, 0xac, 0xf0, 0xdb, 0x28, 0x2f, 0x4d, 0x12, 0x16, 0x94, 0xdb, 0xe5, 0x9d,
-	0xf5, 0x68, 0xdd, 0x47, 0x1e, 0x31, 0xbc, 0x87, 0x1a, 0xb1, 0x14, 0x3a, 0xa5, 0xb1, 0x26, 0x4a,
-	0x66, 0x69, 0x0c, 0x64, 0x48, 0xd5, 0x30, 0x78, 0xc5, 0x12, 0x71, 0x8e, 0xf5, 0x2c, 0xf4, 0x0d,
-	0x55, 0x43, 0xfc, 0x31, 0x7a, 0x6b, 0x90, 0x26, 0x8c, 0x03, 0x01, 0x3d, 0x84, 0x14, 0xb2, 0x31,
-	0xa1, 0x8c, 0xa5, 0xa0, 0x54, 0x50, 0xb1, 0xa2, 0x4d, 0x07, 0x9f, 0x78, 0xf4, 0xa1, 0x03, 0xf1,
-	0x7d, 0xb4, 0xe1, 0x75, 0xf1, 0x90, 0x26, 0xc2, 0xb8, 0xb9, 0xd9, 0x2e, 0xef, 0x54, 0xa2, 0xba,
-	0x0b, 0x1f, 0x99, 0xe8, 0x23, 0x86, 0xbf, 0x44, 0x77, 0x55, 0xc2, 0x05, 0x30, 0x62, 0x3f, 0x29,
-	0x51, 0xa0, 0x89, 0x9e, 0x2a, 0xf2, 0x24, 0x11, 0x4c, 0x3e, 0x09, 0xd6, 0xac, 0x28, 0x70, 0x9c,
-	0x9e, 0xa5, 0xf4, 0x40, 0xf7, 0xa7, 0xea, 0x27, 0x8b, 0xe3, 0x2e, 0xda, 0xf4, 0xfa, 0x01, 0xd5,
-	0xf1, 0x10, 0xe6, 0xc2, 0x57, 0xad, 0xf0, 0x0d, 0x07, 0x1e, 0x3a, 0xcc, 0x6b, 0x3e, 0x47, 0xcd,
-	0xf9, 0x66, 0x0c, 0x4e, 0x75, 0x96, 0x2e, 0x84, 0xb7, 0x5c, 0xc5, 0x9c, 0xd1, 0x9b, 0x13, 0xbc,
-	0x7a, 0x1f, 0x6d, 0x6a, 0x9a, 0x72, 0xd0, 0xe6, 0x44, 0x88, 0x9e, 0x12, 0x9d, 0x8c, 0x41, 0x66,
-	0x3a, 0x40, 0x56, 0x88, 0x1d, 0x78, 0xa2, 0x87, 0xfd, 0x69, 0xdf, 0x21, 0xf8, 0x43, 0x84, 0xe9,
-	0x04, 0x52, 0xca, 0x81, 0x0c, 0x46, 0x32, 0x3e, 0xb3, 0x92, 0xa0, 0x6a, 0xf9, 0xb7, 0x3d, 0x72,
-	0x68, 0x00, 0x23, 0xc0, 0x5f, 0xa0, 0xad, 0x9c, 0x3d, 0xb7, 0x59, 0x90, 0xd5, 0x9c, 0x3f, 0x4f,
-	0xc9, 0xcf, 0x7d, 0x21, 0x17, 0xe8, 0xae, 0x1a, 0x51, 0x35, 0x24, 0xa7, 0xa6, 0x95, 0x89, 0x14,
-	0xcb, 0x27, 0x1b, 0xd4, 0xdb, 0xe5, 0x9d, 0xda, 0x61, 0xf8, 0xec, 0xc5, 0xbd, 0xd2, 0xdf, 0x2f,
-	0xee, 0xdd, 0xe7, 0x89, 0x1e, 0x66, 0x83, 0x30, 0x96, 0xe3, 0x4e, 0x2c, 0xd5, 0x58, 0x2a, 0xff,
-	0xd9, 0x55, 0xec, 0xac, 0xa3, 0x67, 0xe7, 0xa0, 0xc2, 0x63, 0x88, 0xa3, 0xc0, 0xe6, 0xfc, 0xca,
-	0xa7, 0x2c, 0x34, 0x02, 0xff, 0x8a, 0x1a, 0x2b, 0xf5, 0x6c, 0x27, 0x82, 0xd7, 0xae, 0x55, 0x07,
-	0x2f, 0xd5, 0xb1, 0x7d, 0xc3, 0x33, 0xf4, 0xce, 0x4a, 0x85, 0xab, 0xed, 0x0b, 0x36, 0xae, 0x55,
-	0xae, 0xb5, 0x54, 0xee, 0x64, 0xb5, 0xe7, 0xf8, 0x69, 0x19, 0xed, 0xae, 0xd4, 0x8e, 0xa5, 0x38,
-	0x1d, 0x25, 0xb1, 0x4e, 0x04, 0x7f, 0x99, 0x8f, 0xdb, 0xd7, 0xf2, 0xf1, 0xfe, 0x92, 0x8f, 0xa3,
-	0x45, 0x89, 0xab, 0x96, 0x1e, 0xa3, 0xf7, 0x32, 0x31, 0x90, 0x82, 0x11, 0xab, 0x31, 0x36, 0x5e,
-	0x7e, 0x75, 0x5e, 0xb7, 0x7f, 0x94, 0xb6, 0x23, 0xf7, 0x3c, 0xf7, 0xea, 0x15, 0x3a, 0xa8, 0xfc,
-	0xfe, 0x4f, 0xbb, 0xb4, 0xfd, 0x47, 0x05, 0xd5, 0xbe, 0x76, 0x43, 0xac, 0xa7, 0xa9, 0x06, 0xfc,
-	0x01, 0x5a, 0x3b, 0xb7, 0x43, 0xc5, 0x8e, 0x91, 0x6a, 0x17, 0x87, 0x8b, 0xa1, 0x16, 0xba, 0x71,
-	0x13, 0x79, 0x06, 0xfe, 0x0c, 0xdd, 0x19, 0x51, 0xa5, 0x89, 0x1c, 0x28, 0x48, 0x27, 0xc0, 0x08,
-	0x4c, 0x40, 0x68, 0x22, 0xa4, 0x88, 0xc1, 0x0e, 0x97, 0x4a, 0xf4, 0xa6, 0x21, 0x3c, 0xf6, 0xf8,
-	0x89, 0x81, 0xbf, 0x37, 0x28, 0xfe, 0x04, 0xd5, 0x64, 0xa6, 0xb9, 0x34, 0xfb, 0xd0, 0x53, 0x15,
-	0xdc, 0x68, 0xdf, 0xd8, 0xa9, 0x76, 0x1b, 0xa1, 0x1b, 0x77, 0x61, 0x3e, 0xee, 0xc2, 0x87, 0x62,
-	0x16, 0x55, 0x73, 0x66, 0x7f, 0xaa, 0xf0, 0x01, 0xaa, 0x9b, 0x56, 0x24, 0xe9, 0x98, 0x9a, 0x33,
-	0x33, 0xf3, 0xe8, 0xff, 0x95, 0xcb, 0x54, 0x3c, 0x40, 0x5b, 0xf3, 0xd6, 0x39, 0xab, 0x13, 0xa9,
-	0x81, 0xa4, 0x10, 0xcb, 0x94, 0xa9, 0x60, 0xdd, 0x66, 0x7a, 0xb7, 0xb8, 0xe1, 0xbc, 0x0f, 0xd6,
-	0xf9, 0x8f, 0x52, 0x43, 0x64, 0xb9, 0x8b, 0x39, 0xb1, 0x02, 0x28, 0xfc, 0x00, 0xd5, 0x19, 0x8c,
-	0x80, 0x53, 0x0d, 0xe4, 0x0c, 0x66, 0x2a, 0x40, 0x36, 0xeb, 0x56, 0x31, 0xeb, 0x77, 0x8a, 0x1f,
-	0x7b, 0xce, 0xb7, 0x30, 0x53, 0x51, 0x8d, 0x15, 0x56, 0xf8, 0x01, 0xda, 0x80, 0x34, 0xee, 0xee,
-	0x11, 0x2d, 0x09, 0x03, 0x21, 0xc7, 0x2a, 0xa8, 0xda, 0x1c, 0xc1, 0x92, 0xb3, 0xe8, 0xa8, 0xbb,
-	0xd7, 0x97, 0xc7, 0x86, 0x10, 0xd5, 0xad, 0xc0, 0xaf, 0x14, 0xfe, 0x05, 0xb5, 0x32, 0xe1, 0x06,
-	0x23, 0x23, 0x0a, 0x04, 0x33, 0xa9, 0xe6, 0x3b, 0x37, 0xc7, 0x5d, 0xb3, 0x09, 0x9b, 0xc5, 0x84,
-	0x3d, 0x10, 0xac, 0x2f, 0xf3, 0x0d, 0x47, 0xcd, 0x79, 0x86, 0x65, 0xa0, 0x3f, 0x55, 0xdb, 0x07,
-	0xa8, 0x56, 0x2c, 0x8f, 0x1b, 0xe8, 0xa6, 0x35, 0xe0, 0x5f, 0x1e, 0xb7, 0x30, 0x51, 0x6b, 0xdf,
-	0x3f, 0x33, 0x6e, 0x71, 0x18, 0x3d, 0xbb, 0x68, 0x95, 0x9f, 0x5f, 0xb4, 0xca, 0xff, 0x5e, 0xb4,
-	0xca, 0x4f, 0x2f, 0x5b, 0xa5, 0xe7, 0x97, 0xad, 0xd2, 0x5f, 0x97, 0xad, 0xd2, 0xcf, 0x9f, 0x16,
-	0x2e, 0xcd, 0x39, 0x70, 0x3e, 0xfb, 0x6d, 0x92, 0xbf, 0x91, 0xbb, 0xee, 0xf5, 0xe8, 0x8c, 0x25,
-	0xcb, 0x46, 0xd0, 0x99, 0xe6, 0x71, 0x77, 0x95, 0x06, 0x6b, 0xb6, 0xe9, 0x1f, 0xfd, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x37, 0xc4, 0xc6, 0x03, 0x9a, 0x07, 0x00, 0x00,
+func (m *ERC20EscrowBalance) Reset()         { *m = ERC20EscrowBalance{} }
+func (m *ERC20EscrowBalance) String() string { return proto.CompactTextString(m) }
+func (*ERC20EscrowBalance) ProtoMessage()    {}
+func (*ERC20EscrowBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20EscrowBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20EscrowBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20EscrowBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20EscrowBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20EscrowBalance.Merge(m, src)
 }
-
+func (m *ERC20EscrowBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20EscrowBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20EscrowBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20EscrowBalance proto.InternalMessageInfo
+
+func (m *ERC20EscrowBalance) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// MissedOutgoingTxs records the indexes in the outgoing tx signing window of
+// the outgoing txs a validator missed
+type MissedOutgoingTxs struct {
+	ValidatorAddress string   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Indexes          []uint64 `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *MissedOutgoingTxs) Reset()         { *m = MissedOutgoingTxs{} }
+func (m *MissedOutgoingTxs) String() string { return proto.CompactTextString(m) }
+func (*MissedOutgoingTxs) ProtoMessage()    {}
+func (*MissedOutgoingTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *MissedOutgoingTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedOutgoingTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedOutgoingTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedOutgoingTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedOutgoingTxs.Merge(m, src)
+}
+func (m *MissedOutgoingTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedOutgoingTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedOutgoingTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedOutgoingTxs proto.InternalMessageInfo
+
+func (m *MissedOutgoingTxs) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MissedOutgoingTxs) GetIndexes() []uint64 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// This records the relationship between an ERC20 token and the denom
+// of the corresponding Cosmos originated asset
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{7}
+}
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QuarantinedDeposit is a SendToCosmosEvent which failed to credit its cosmos
+// receiver, until it is credited to another cosmos address or sent back to its
+// ethereum sender
+type QuarantinedDeposit struct {
+	Event *SendToCosmosEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// amount is the amount of vouchers the deposit is worth
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Reason string                                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height uint64                                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{8}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetEvent() *SendToCosmosEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *QuarantinedDeposit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.UnregisteredERC20Policy", UnregisteredERC20Policy_name, UnregisteredERC20Policy_value)
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*EventTypePowerThreshold)(nil), "gravity.v1.EventTypePowerThreshold")
+	proto.RegisterType((*DepositPowerThreshold)(nil), "gravity.v1.DepositPowerThreshold")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*LastEventNonceByValidator)(nil), "gravity.v1.LastEventNonceByValidator")
+	proto.RegisterType((*ERC20EscrowBalance)(nil), "gravity.v1.ERC20EscrowBalance")
+	proto.RegisterType((*MissedOutgoingTxs)(nil), "gravity.v1.MissedOutgoingTxs")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*QuarantinedDeposit)(nil), "gravity.v1.QuarantinedDeposit")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0x6d, 0x45, 0x8e, 0x46, 0x92, 0x25, 0x8f, 0x28, 0x71, 0x44, 0x49, 0x14, 0xad, 0xc4,
+	0x86, 0x92, 0xd4, 0x94, 0xad, 0xa0, 0x69, 0xeb, 0xfe, 0x41, 0x28, 0x92, 0xb1, 0x95, 0xda, 0x96,
+	0xbc, 0xa2, 0x12, 0xd4, 0x28, 0xba, 0x18, 0xee, 0x0e, 0x97, 0x6b, 0x93, 0x3b, 0xcc, 0xce, 0x90,
+	0x22, 0x81, 0x1e, 0xda, 0x02, 0x2d, 0x8a, 0x9c, 0x02, 0xf4, 0xd2, 0x4b, 0x4e, 0x05, 0xfa, 0x3d,
+	0x7a, 0xcb, 0x31, 0x87, 0x1e, 0x8a, 0xa2, 0x48, 0x0b, 0xfb, 0x8b, 0x14, 0xf3, 0x66, 0x96, 0xbb,
+	0x4b, 0x52, 0x69, 0xad, 0xf6, 0x24, 0xed, 0xbc, 0xdf, 0xfb, 0xbd, 0x37, 0xef, 0xbd, 0x79, 0xf3,
+	0x86, 0x88, 0x78, 0x21, 0xed, 0xfb, 0x72, 0xb8, 0xdf, 0xbf, 0xb7, 0xef, 0xb1, 0x80, 0x09, 0x5f,
+	0x94, 0xba, 0x21, 0x97, 0x1c, 0x23, 0x23, 0x29, 0xf5, 0xef, 0xe5, 0xb3, 0x1e, 0xf7, 0x38, 0x2c,
+	0xef, 0xab, 0xff, 0x34, 0x22, 0x9f, 0xd2, 0x35, 0x60, 0x2d, 0x59, 0x4b, 0x48, 0x3a, 0xc2, 0x33,
+	0x94, 0xf9, 0x0d, 0x8f, 0x73, 0xaf, 0xcd, 0xf6, 0xe1, 0xab, 0xd1, 0x6b, 0xee, 0xd3, 0x20, 0xd2,
+	0x28, 0x8c, 0x8b, 0xdc, 0x5e, 0x48, 0xa5, 0xcf, 0x03, 0x2d, 0xdf, 0xfd, 0xf3, 0x2a, 0x9a, 0x3b,
+	0xa1, 0x21, 0xed, 0x08, 0xbc, 0x8d, 0x22, 0xd7, 0x6c, 0xdf, 0x25, 0x99, 0x62, 0x66, 0x6f, 0xde,
+	0x9a, 0x37, 0x2b, 0x47, 0x2e, 0xbe, 0x8b, 0xb2, 0x0e, 0x0f, 0x64, 0x48, 0x1d, 0x69, 0x0b, 0xde,
+	0x0b, 0x1d, 0x66, 0xb7, 0xa8, 0x68, 0x91, 0x2b, 0x00, 0xc4, 0x91, 0xec, 0x14, 0x44, 0x0f, 0xa9,
+	0x68, 0xe1, 0x0f, 0x50, 0xae, 0x11, 0xfa, 0xae, 0xc7, 0x6c, 0x26, 0x5b, 0x2c, 0x64, 0xbd, 0x8e,
+	0x4d, 0x5d, 0x37, 0x64, 0x42, 0x90, 0x59, 0x50, 0x5a, 0xd3, 0xe2, 0x9a, 0x91, 0x96, 0xb5, 0x10,
+	0xdf, 0x46, 0xcb, 0x46, 0xcf, 0x69, 0x51, 0x3f, 0x50, 0xde, 0xbc, 0x51, 0xcc, 0xec, 0xcd, 0x5a,
+	0x4b, 0x7a, 0xb9, 0xa2, 0x56, 0x8f, 0x5c, 0xfc, 0x13, 0xb4, 0x25, 0x7c, 0x2f, 0x60, 0xae, 0x0d,
+	0x7f, 0x42, 0x5b, 0x30, 0x69, 0xcb, 0x81, 0xb0, 0xcf, 0xfd, 0xc0, 0xe5, 0xe7, 0x64, 0x0e, 0x94,
+	0x88, 0xc6, 0x9c, 0x02, 0xe4, 0x94, 0xc9, 0xfa, 0x40, 0x7c, 0x0a, 0x72, 0x7c, 0x80, 0xd6, 0x8c,
+	0x7e, 0x83, 0x4a, 0xa7, 0xc5, 0x46, 0x8a, 0xd7, 0x40, 0x71, 0x55, 0x0b, 0x0f, 0xb5, 0xcc, 0xe8,
+	0xfc, 0x08, 0xe5, 0x47, 0x9b, 0x51, 0x72, 0x2a, 0x7b, 0x61, 0xac, 0xf8, 0xa6, 0xb6, 0x18, 0x21,
+	0x4e, 0x47, 0x00, 0xa3, 0x7d, 0x0f, 0xad, 0x49, 0x1a, 0x7a, 0x4c, 0xaa, 0x88, 0xd8, 0x72, 0x60,
+	0x4b, 0xbf, 0xc3, 0x78, 0x4f, 0x12, 0x04, 0x8a, 0x58, 0x0b, 0x6b, 0xb2, 0x55, 0x1f, 0xd4, 0xb5,
+	0x04, 0x7f, 0x07, 0x61, 0xda, 0x67, 0x21, 0xf5, 0x98, 0xdd, 0x68, 0x73, 0xe7, 0x05, 0xa8, 0x90,
+	0x05, 0xc0, 0xaf, 0x18, 0xc9, 0xa1, 0x12, 0x28, 0x05, 0xfc, 0x63, 0xb4, 0x19, 0xa1, 0x47, 0x6e,
+	0x26, 0xd4, 0x16, 0xb5, 0x7f, 0x06, 0x12, 0xc5, 0x3d, 0x56, 0xef, 0xa2, 0x2d, 0xd1, 0xa6, 0xa2,
+	0x65, 0x37, 0x55, 0x2a, 0x7d, 0x1e, 0xa4, 0x23, 0x4b, 0x96, 0x8a, 0x99, 0xbd, 0xc5, 0xc3, 0xbb,
+	0x5f, 0x7d, 0xb3, 0x33, 0xf3, 0xf7, 0x6f, 0x76, 0x6e, 0x7b, 0xbe, 0x6c, 0xf5, 0x1a, 0x25, 0x87,
+	0x77, 0xf6, 0x1d, 0x2e, 0x3a, 0x5c, 0x98, 0x3f, 0x77, 0x84, 0xfb, 0x62, 0x5f, 0x0e, 0xbb, 0x4c,
+	0x94, 0xaa, 0xcc, 0x21, 0x19, 0x8b, 0x00, 0xeb, 0x47, 0x86, 0x34, 0x91, 0x0a, 0xdc, 0x40, 0xd9,
+	0x31, 0x8b, 0x90, 0x0b, 0x72, 0xfd, 0x92, 0x96, 0x70, 0xca, 0x12, 0xe4, 0x0e, 0x0f, 0xd1, 0xcd,
+	0x31, 0x1b, 0x93, 0x29, 0x24, 0xcb, 0x60, 0xb0, 0xf4, 0x7a, 0x06, 0xad, 0x42, 0xca, 0x5c, 0x6d,
+	0x3c, 0xef, 0xf8, 0x8b, 0x0c, 0xba, 0x33, 0x66, 0xdb, 0xe1, 0x41, 0xb3, 0xed, 0x3b, 0xd2, 0x0f,
+	0xbc, 0x69, 0x7e, 0xac, 0x5c, 0xca, 0x8f, 0x77, 0x52, 0x7e, 0x54, 0x62, 0x13, 0x93, 0x2e, 0x1d,
+	0xa3, 0x5b, 0xbd, 0xa0, 0xc1, 0x03, 0xd7, 0x06, 0x1d, 0xe5, 0xc6, 0xf4, 0xe3, 0x73, 0x03, 0x8a,
+	0xa5, 0xa8, 0xc1, 0xa7, 0x06, 0x3b, 0xe5, 0x18, 0xd5, 0xd0, 0x4e, 0x83, 0xb6, 0x69, 0xe0, 0x30,
+	0xdb, 0x65, 0x6d, 0x49, 0x6d, 0xea, 0x38, 0xbc, 0x17, 0xc0, 0x06, 0x25, 0x7f, 0xc1, 0x02, 0x41,
+	0x70, 0xf1, 0xea, 0xde, 0xbc, 0xb5, 0x65, 0x60, 0x55, 0x85, 0x2a, 0x8f, 0x40, 0x75, 0xc0, 0xe0,
+	0x17, 0x28, 0xcf, 0xfa, 0x2c, 0x90, 0x76, 0x9f, 0x4b, 0x66, 0x77, 0xf9, 0x39, 0x0b, 0x6d, 0xd9,
+	0x0a, 0x99, 0x68, 0xf1, 0xb6, 0x4b, 0x56, 0x2f, 0x15, 0x96, 0x1c, 0x30, 0x7e, 0xc2, 0x25, 0x3b,
+	0x51, 0x7c, 0xf5, 0x88, 0x0e, 0xb7, 0xd0, 0xa6, 0x36, 0xa6, 0xb0, 0xe3, 0xc6, 0x04, 0xc9, 0x16,
+	0xaf, 0xee, 0x2d, 0x1c, 0xbc, 0x55, 0x8a, 0x5b, 0x75, 0xa9, 0xa6, 0xe0, 0xf5, 0x61, 0x77, 0x8c,
+	0xe9, 0x70, 0x56, 0xb9, 0x64, 0x11, 0x36, 0x5d, 0x2c, 0x30, 0x45, 0xc4, 0x65, 0x5d, 0x2e, 0x7c,
+	0x39, 0x69, 0x66, 0x0d, 0xcc, 0xdc, 0x4c, 0x9a, 0xa9, 0x6a, 0xec, 0x54, 0x23, 0xeb, 0xee, 0x34,
+	0xa1, 0x50, 0x9d, 0xb9, 0x43, 0x07, 0xe9, 0x62, 0x62, 0xa1, 0x20, 0xeb, 0xba, 0xa9, 0x74, 0xe8,
+	0x20, 0x59, 0x05, 0x2c, 0x14, 0x58, 0xa2, 0x9d, 0x44, 0xce, 0xb5, 0x5f, 0xae, 0xdf, 0x6c, 0x26,
+	0x02, 0x9e, 0xbb, 0x54, 0xc0, 0x37, 0x45, 0x54, 0x1f, 0xe0, 0x64, 0xd5, 0x6f, 0x36, 0xe3, 0xa0,
+	0xbf, 0x87, 0x70, 0xc2, 0xaa, 0x72, 0x99, 0x7a, 0x8c, 0x10, 0xf0, 0x72, 0x79, 0xa4, 0xf8, 0x98,
+	0x0e, 0xca, 0x1e, 0xc3, 0xdf, 0x45, 0xb9, 0x24, 0x58, 0x5d, 0x03, 0x81, 0x64, 0x61, 0x9f, 0xb6,
+	0xc9, 0x06, 0x68, 0x64, 0x63, 0x0d, 0x3f, 0x38, 0x32, 0x32, 0xfc, 0x43, 0x94, 0xe7, 0x3d, 0xe9,
+	0x71, 0x28, 0xbe, 0x01, 0x84, 0x42, 0xfd, 0x6b, 0x4a, 0x3a, 0x0f, 0x9a, 0xb9, 0x08, 0x51, 0x1f,
+	0x9c, 0x6a, 0xb9, 0xa9, 0xe4, 0x21, 0xda, 0x55, 0x86, 0xcc, 0xa5, 0x90, 0xe0, 0x11, 0x76, 0x97,
+	0x85, 0x11, 0xc9, 0xe6, 0xa5, 0x22, 0xb3, 0xdd, 0xf1, 0x75, 0xe3, 0x73, 0x8f, 0x47, 0xd6, 0xc5,
+	0x09, 0x0b, 0x8d, 0x69, 0x8e, 0x76, 0x93, 0x7e, 0xbb, 0xfc, 0x3c, 0x50, 0x1d, 0xdb, 0x7e, 0x4e,
+	0xfd, 0xb6, 0x1d, 0xdd, 0xd9, 0x64, 0xab, 0x98, 0xd9, 0x5b, 0x38, 0xd8, 0x28, 0xe9, 0x4b, 0xbd,
+	0x14, 0x5d, 0xea, 0xa5, 0xaa, 0x01, 0x1c, 0xbe, 0xa9, 0xbc, 0xfa, 0xe3, 0x3f, 0x77, 0x32, 0x56,
+	0x21, 0xde, 0x64, 0xd5, 0x90, 0x7d, 0x4c, 0xfd, 0x76, 0x84, 0xc4, 0xbf, 0x44, 0x6f, 0x8d, 0x35,
+	0xa6, 0x69, 0xf6, 0xc9, 0xf6, 0xa5, 0x36, 0xbb, 0x93, 0x6a, 0x47, 0xc7, 0x13, 0x9e, 0xa8, 0x9e,
+	0x11, 0x9d, 0x8a, 0xcf, 0x7a, 0x34, 0xa4, 0xaa, 0x11, 0x30, 0x3b, 0x64, 0xcd, 0x5e, 0xe0, 0xaa,
+	0x2e, 0x42, 0x87, 0xa4, 0x00, 0xb9, 0xda, 0x32, 0xb0, 0xa7, 0x23, 0x94, 0x05, 0xa0, 0xaa, 0xc2,
+	0x60, 0x1b, 0x6d, 0xf4, 0x82, 0x90, 0x79, 0xbe, 0x90, 0x2c, 0x64, 0xae, 0xcd, 0x42, 0xe7, 0xe0,
+	0xae, 0xdd, 0xe5, 0x6d, 0xdf, 0x19, 0x92, 0x9d, 0x62, 0x66, 0xef, 0x7a, 0xfa, 0x10, 0x9f, 0x25,
+	0xc0, 0x35, 0xab, 0x72, 0x70, 0xf7, 0x04, 0xa0, 0x56, 0x2e, 0xc9, 0x52, 0x0b, 0x9d, 0x48, 0x80,
+	0x7f, 0x9d, 0x41, 0xb7, 0xc7, 0xc2, 0xa4, 0xfb, 0xe1, 0x44, 0xdb, 0x24, 0xc5, 0x4b, 0x45, 0xea,
+	0x66, 0x2a, 0x52, 0x67, 0x11, 0x77, 0xa2, 0xcd, 0xde, 0x9f, 0xfd, 0xd5, 0x3f, 0x8a, 0x33, 0xbb,
+	0xbf, 0xcb, 0xa0, 0xdc, 0x05, 0x3d, 0x48, 0x4d, 0x6e, 0x71, 0x37, 0x8b, 0x26, 0xb7, 0x51, 0x47,
+	0xc2, 0x8f, 0xd0, 0x7c, 0x7c, 0xae, 0xaf, 0x5c, 0xca, 0xcd, 0x98, 0x60, 0xf7, 0xaf, 0x19, 0xb4,
+	0x36, 0xb5, 0x4b, 0xe1, 0x5b, 0xe8, 0x3a, 0xf4, 0x7b, 0x3b, 0x9a, 0x05, 0x8d, 0x2b, 0x4b, 0xb0,
+	0x5a, 0x31, 0x8b, 0xf8, 0x23, 0x34, 0x47, 0x3b, 0xaa, 0xf7, 0xeb, 0xd1, 0xf1, 0xb5, 0x7c, 0x39,
+	0x0a, 0xa4, 0x65, 0xb4, 0xd3, 0xdb, 0xba, 0xfa, 0xbf, 0x6e, 0xeb, 0x0f, 0xcb, 0x68, 0xf1, 0x81,
+	0x1e, 0xd4, 0x4f, 0x25, 0x95, 0x0c, 0xbf, 0x8b, 0xe6, 0xba, 0x30, 0x18, 0xc3, 0x2e, 0x16, 0x0e,
+	0x70, 0xb2, 0x90, 0xf4, 0xc8, 0x6c, 0x19, 0x04, 0xfe, 0x01, 0xda, 0x68, 0x53, 0x21, 0x6d, 0xde,
+	0x10, 0x2c, 0xec, 0xab, 0x42, 0x84, 0x74, 0x04, 0x3c, 0x70, 0x18, 0xec, 0x72, 0xd6, 0x5a, 0x57,
+	0x80, 0x63, 0x23, 0x87, 0x44, 0x3e, 0x51, 0x52, 0xfc, 0x3d, 0xb4, 0x98, 0x6c, 0x34, 0xe4, 0x2a,
+	0xdc, 0x09, 0xd9, 0x89, 0x23, 0x5e, 0x0e, 0x86, 0xd6, 0x42, 0x7c, 0xa6, 0x05, 0xbe, 0x8f, 0x96,
+	0xd4, 0x28, 0xe1, 0x87, 0x1d, 0x38, 0xd0, 0x6a, 0xa6, 0xbe, 0x58, 0x33, 0x0d, 0xc5, 0x0d, 0xb4,
+	0x39, 0xba, 0x2d, 0x12, 0x97, 0x6e, 0xc8, 0x1c, 0x1e, 0xba, 0x82, 0xcc, 0x4f, 0xb9, 0xfe, 0x0c,
+	0xbc, 0x16, 0x5d, 0xa8, 0x16, 0x60, 0xe3, 0x59, 0x77, 0x4c, 0x20, 0xf0, 0x87, 0x68, 0xc9, 0x65,
+	0x6d, 0xe6, 0x51, 0xc9, 0xec, 0x17, 0x6c, 0x28, 0x08, 0x02, 0xd6, 0xcd, 0x24, 0xeb, 0x63, 0xe1,
+	0x55, 0x0d, 0xe6, 0xa7, 0x6c, 0x28, 0xac, 0x45, 0x37, 0xf1, 0x85, 0x3f, 0x44, 0xcb, 0xfa, 0x40,
+	0x4b, 0x6e, 0xbb, 0x2c, 0xe0, 0x1d, 0x41, 0x16, 0x80, 0x83, 0xa4, 0x3c, 0x53, 0xe7, 0xb8, 0xce,
+	0xab, 0x0a, 0x60, 0x2d, 0x81, 0x82, 0xf9, 0x12, 0xf8, 0x17, 0xa8, 0xd0, 0x0b, 0xf4, 0x70, 0xef,
+	0xda, 0x82, 0x05, 0xae, 0xa2, 0x1a, 0xed, 0x5c, 0x85, 0x7b, 0x11, 0x08, 0xf3, 0x49, 0xc2, 0x53,
+	0x16, 0xb8, 0x75, 0x1e, 0x6d, 0xd8, 0xca, 0x8f, 0x18, 0xd2, 0x82, 0xfa, 0x20, 0x91, 0xf7, 0x28,
+	0x83, 0x80, 0x34, 0x79, 0x5f, 0x4a, 0xe4, 0xdd, 0xc8, 0x61, 0x1e, 0xd5, 0x79, 0xff, 0x00, 0x11,
+	0x50, 0x9d, 0xf0, 0xca, 0x77, 0x61, 0xf8, 0x9d, 0xb5, 0xb2, 0x4a, 0x9e, 0xb6, 0x79, 0xe4, 0xe2,
+	0xfb, 0x28, 0xdf, 0xa6, 0x92, 0x29, 0xcd, 0x64, 0xfb, 0x31, 0x36, 0x97, 0x23, 0x9b, 0x0a, 0x91,
+	0x68, 0x22, 0xda, 0xe6, 0x19, 0xda, 0x4c, 0x97, 0x69, 0xba, 0x83, 0xad, 0x40, 0x9d, 0xe7, 0x52,
+	0xb1, 0x88, 0x29, 0xac, 0x5c, 0xb2, 0x82, 0x13, 0x02, 0xdc, 0x42, 0xdb, 0x63, 0xd5, 0x1f, 0xed,
+	0xa5, 0xc5, 0x7c, 0xaf, 0x25, 0x61, 0x92, 0x5c, 0x38, 0xb8, 0x95, 0x24, 0x7e, 0x04, 0x1e, 0xa6,
+	0x5e, 0x20, 0x0f, 0x01, 0x6c, 0xe5, 0x53, 0x07, 0xc5, 0x00, 0xb4, 0x4c, 0xcd, 0xae, 0x3a, 0x68,
+	0xaa, 0x69, 0xa6, 0xaf, 0x68, 0xf3, 0xcc, 0x31, 0x16, 0xb1, 0x9e, 0x5d, 0x21, 0x82, 0x1a, 0x1b,
+	0x5f, 0x42, 0x09, 0x63, 0xea, 0xbd, 0x04, 0x84, 0x71, 0x53, 0x4f, 0xd1, 0xac, 0xea, 0xf7, 0x92,
+	0x82, 0x8c, 0x5a, 0x73, 0x52, 0xfd, 0x53, 0xf4, 0x0e, 0xa8, 0x8f, 0xbf, 0x6f, 0xd5, 0xc3, 0x35,
+	0xf0, 0x58, 0x9a, 0x2c, 0x0b, 0x64, 0x6f, 0x2b, 0x85, 0xb1, 0x17, 0x6f, 0x05, 0xd0, 0x49, 0x62,
+	0x1f, 0x15, 0x34, 0x71, 0xdc, 0x47, 0x84, 0xdd, 0x18, 0xda, 0x7d, 0xda, 0xf6, 0x5d, 0x2a, 0x79,
+	0x68, 0x66, 0xc7, 0xb1, 0x98, 0x0a, 0x19, 0x77, 0x96, 0xc3, 0xe1, 0x27, 0x11, 0x58, 0xc7, 0x34,
+	0x16, 0x89, 0x84, 0x0c, 0x5b, 0x68, 0x4d, 0x9f, 0x32, 0x26, 0x9c, 0x90, 0x9f, 0xdb, 0x66, 0x48,
+	0x57, 0xe3, 0xa3, 0xb2, 0x50, 0x98, 0x38, 0x6b, 0x35, 0xc0, 0x1d, 0x6a, 0x98, 0xb5, 0x0a, 0xca,
+	0xa9, 0x35, 0x81, 0x7f, 0x8e, 0x36, 0xa6, 0x4d, 0x61, 0x7e, 0xd0, 0xe4, 0x82, 0xe4, 0x26, 0xa7,
+	0xde, 0xe3, 0xf1, 0x81, 0xec, 0x28, 0x68, 0x72, 0x6b, 0x9d, 0x4f, 0x5b, 0x16, 0xf8, 0x31, 0x5a,
+	0xed, 0xf8, 0x42, 0x8c, 0x8d, 0x68, 0x84, 0x00, 0xef, 0x76, 0xaa, 0xbf, 0x00, 0x2c, 0x66, 0x17,
+	0xd6, 0x8d, 0xce, 0xf8, 0x12, 0x7e, 0x8a, 0xb2, 0xf1, 0x0c, 0xa2, 0xa6, 0x0f, 0xb8, 0xdb, 0x04,
+	0xd9, 0x98, 0xdc, 0x7f, 0x3c, 0x85, 0xb8, 0xe6, 0x0a, 0xb4, 0x56, 0x3f, 0x9b, 0x58, 0x13, 0xb8,
+	0x8c, 0x16, 0xa3, 0xce, 0x05, 0xef, 0x9f, 0xfc, 0x05, 0xa1, 0x84, 0xa7, 0xcf, 0x63, 0x26, 0xa9,
+	0x4b, 0x25, 0xb5, 0x16, 0x4c, 0xf3, 0x52, 0x2a, 0xf8, 0x0c, 0xad, 0xeb, 0x2b, 0xcb, 0x0c, 0x35,
+	0xb4, 0xdb, 0x0d, 0x79, 0x9f, 0xb6, 0x05, 0xd9, 0x04, 0xb2, 0x9d, 0x24, 0x59, 0x05, 0x90, 0x40,
+	0x59, 0x36, 0x38, 0x2b, 0xab, 0xd5, 0x61, 0x9a, 0x89, 0x16, 0x05, 0x7e, 0x86, 0x36, 0xba, 0x4c,
+	0x57, 0xba, 0xe6, 0x75, 0x59, 0xb7, 0xcd, 0x87, 0x1d, 0x16, 0x48, 0x41, 0xb6, 0x2e, 0x70, 0xb3,
+	0x0a, 0x18, 0x73, 0x6d, 0x59, 0x39, 0x43, 0x00, 0xcc, 0xd5, 0x58, 0x1d, 0x3f, 0x45, 0x24, 0x64,
+	0x1d, 0xda, 0xed, 0x8e, 0x26, 0xb1, 0xb8, 0x71, 0x6f, 0xff, 0x87, 0xc6, 0xbd, 0x16, 0x69, 0xd6,
+	0x92, 0x0d, 0x7c, 0xd7, 0x47, 0x1b, 0x17, 0x56, 0x35, 0x7e, 0x0f, 0xdd, 0x18, 0x9d, 0x87, 0xd1,
+	0x2f, 0x4b, 0x7a, 0xe4, 0x58, 0x19, 0x09, 0xa2, 0x1f, 0x95, 0x76, 0xd0, 0xc2, 0xe4, 0xa5, 0x8c,
+	0xd8, 0x88, 0x78, 0xf7, 0xb7, 0x19, 0x84, 0x27, 0xeb, 0xfb, 0xbf, 0x1d, 0x6a, 0x1e, 0xa2, 0x6b,
+	0xe6, 0xe0, 0x5c, 0x72, 0xaa, 0x89, 0xd4, 0x77, 0x9f, 0xa1, 0x1b, 0x13, 0x65, 0xfb, 0x7a, 0x5b,
+	0x25, 0xe8, 0x9a, 0x1f, 0xb8, 0x6c, 0xc0, 0x04, 0xb9, 0x52, 0xbc, 0xba, 0x37, 0x6b, 0x45, 0x9f,
+	0xbb, 0xf7, 0xd1, 0x62, 0x32, 0xea, 0x38, 0x8b, 0xde, 0x80, 0x44, 0x19, 0x2a, 0xfd, 0xa1, 0x56,
+	0x21, 0x6b, 0xe6, 0xa7, 0x3d, 0xfd, 0xb1, 0xfb, 0x97, 0x0c, 0xc2, 0x93, 0xf5, 0x8f, 0xdf, 0x47,
+	0x6f, 0x40, 0x10, 0xcd, 0x94, 0xb4, 0x3d, 0x79, 0x93, 0x9a, 0xe2, 0x84, 0xda, 0xd1, 0xd8, 0xff,
+	0xdb, 0x08, 0xb8, 0x8e, 0xe6, 0x42, 0x46, 0x05, 0x0f, 0x60, 0xfe, 0x9b, 0xb7, 0xcc, 0x97, 0x5a,
+	0x37, 0x4d, 0x77, 0x16, 0xf2, 0x6c, 0xbe, 0xde, 0xfd, 0xcd, 0x15, 0x94, 0xbb, 0xe0, 0x0d, 0x80,
+	0x1f, 0xa0, 0xe2, 0xd9, 0x13, 0xab, 0xf6, 0xe0, 0xe8, 0xb4, 0x5e, 0xb3, 0x6a, 0x55, 0x1b, 0x64,
+	0xf6, 0xc9, 0xf1, 0xa3, 0xa3, 0xca, 0xcf, 0xec, 0x72, 0xa5, 0x52, 0x3b, 0xa9, 0xaf, 0xcc, 0xe4,
+	0x6f, 0x7e, 0xfe, 0x65, 0x71, 0xfb, 0x02, 0x8a, 0xb2, 0xe3, 0xb0, 0xae, 0xfc, 0x76, 0x22, 0xab,
+	0xf6, 0x71, 0xad, 0x52, 0x5f, 0xc9, 0x7c, 0x2b, 0x91, 0xc5, 0x9e, 0x33, 0x47, 0xdd, 0x76, 0x6f,
+	0x5f, 0x4c, 0xf4, 0xf4, 0xac, 0x6c, 0x95, 0x9f, 0xd4, 0x8f, 0x9e, 0xd4, 0x56, 0xae, 0xe4, 0x6f,
+	0x7d, 0xfe, 0x65, 0xf1, 0xe6, 0x05, 0x64, 0x71, 0xce, 0xf2, 0xb3, 0xbf, 0xff, 0x53, 0x61, 0xe6,
+	0xd0, 0xfa, 0xea, 0x65, 0x21, 0xf3, 0xf5, 0xcb, 0x42, 0xe6, 0x5f, 0x2f, 0x0b, 0x99, 0x2f, 0x5e,
+	0x15, 0x66, 0xbe, 0x7e, 0x55, 0x98, 0xf9, 0xdb, 0xab, 0xc2, 0xcc, 0xb3, 0xef, 0x27, 0xc2, 0xdf,
+	0x65, 0x9e, 0x37, 0x7c, 0xde, 0x8f, 0x7e, 0x80, 0xbe, 0xa3, 0x7f, 0x7a, 0xdd, 0xef, 0x70, 0xb7,
+	0xd7, 0x66, 0xfb, 0x83, 0x68, 0x5d, 0x27, 0xa5, 0x31, 0x07, 0xd3, 0xe6, 0xfb, 0xff, 0x1e, 0x00,
+	0x2f, 0xd4, 0x1b, 0xdc, 0xf7, 0x16, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SlashFractionUnbondingSignerSetTx.Size()
+		i -= size
+		if _, err := m.SlashFractionUnbondingSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.UnregisteredErc20Policy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnregisteredErc20Policy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.DepositQuarantineRefundDelay != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositQuarantineRefundDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.SlashFractionOutgoingTxDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionOutgoingTxDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.OutgoingTxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.OutgoingTxDowntimeJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MinSignedOutgoingTxsPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedOutgoingTxsPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.OutgoingTxSigningWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutgoingTxSigningWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.SignerSetMinInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetMinInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SignerSetMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetMaxAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.SignerSetPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.SignerSetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.MaxEthereumSigners != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxEthereumSigners))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.DepositPowerThresholds) > 0 {
+		for iNdEx := len(m.DepositPowerThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositPowerThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.EventTypePowerThresholds) > 0 {
+		for iNdEx := len(m.EventTypePowerThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventTypePowerThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size := m.EventVotePowerThreshold.Size()
+		i -= size
+		if _, err := m.EventVotePowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.BalanceDeltaAccountingTokens) > 0 {
+		for iNdEx := len(m.BalanceDeltaAccountingTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BalanceDeltaAccountingTokens[iNdEx])
+			copy(dAtA[i:], m.BalanceDeltaAccountingTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BalanceDeltaAccountingTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
 		i--
@@ -569,7 +1432,97 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *EventTypePowerThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTypePowerThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTypePowerThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositPowerThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositPowerThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositPowerThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -589,6 +1542,214 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemappedErc20ToDenoms) > 0 {
+		for iNdEx := len(m.RemappedErc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemappedErc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.PendingErc20Deployments) > 0 {
+		for iNdEx := len(m.PendingErc20Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingErc20Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.CosmosErc20Approvals) > 0 {
+		for iNdEx := len(m.CosmosErc20Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CosmosErc20Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Erc20Tokens) > 0 {
+		for iNdEx := len(m.Erc20Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MissedOutgoingTxs) > 0 {
+		for iNdEx := len(m.MissedOutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedOutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.OutgoingTxSigningInfos) > 0 {
+		for iNdEx := len(m.OutgoingTxSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Erc20EscrowBalances) > 0 {
+		for iNdEx := len(m.Erc20EscrowBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20EscrowBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.LastEthereumAddressChangeBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEthereumAddressChangeBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LastSlashedOutgoingTxBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedOutgoingTxBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastObservedEthereumHeight != nil {
+		{
+			size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.LastObservedSignerSetTx != nil {
+		{
+			size, err := m.LastObservedSignerSetTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchNonce))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.UnbatchedSendToEthereumTxs) > 0 {
 		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -693,7 +1854,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+func (m *LastEventNonceByValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -703,56 +1864,236 @@ func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+func (m *LastEventNonceByValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LastEventNonceByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ERC20EscrowBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ERC20EscrowBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20EscrowBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedOutgoingTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedOutgoingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedOutgoingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA6 := make([]byte, len(m.Indexes)*10)
+		var j5 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	l = len(m.BridgeEthereumAddress)
@@ -791,6 +2132,86 @@ func (m *Params) Size() (n int) {
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
 	}
+	if len(m.BalanceDeltaAccountingTokens) > 0 {
+		for _, s := range m.BalanceDeltaAccountingTokens {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EventVotePowerThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.EventTypePowerThresholds) > 0 {
+		for _, e := range m.EventTypePowerThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositPowerThresholds) > 0 {
+		for _, e := range m.DepositPowerThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxEthereumSigners != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxEthereumSigners))
+	}
+	l = m.SignerSetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignerSetMaxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetMaxAge))
+	}
+	if m.SignerSetMinInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetMinInterval))
+	}
+	if m.OutgoingTxSigningWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.OutgoingTxSigningWindow))
+	}
+	l = m.MinSignedOutgoingTxsPerWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.OutgoingTxDowntimeJailDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionOutgoingTxDowntime.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.DepositQuarantineRefundDelay != 0 {
+		n += 2 + sovGenesis(uint64(m.DepositQuarantineRefundDelay))
+	}
+	if m.UnregisteredErc20Policy != 0 {
+		n += 2 + sovGenesis(uint64(m.UnregisteredErc20Policy))
+	}
+	l = m.SlashFractionUnbondingSignerSetTx.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EventTypePowerThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DepositPowerThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -843,6 +2264,137 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LastSendToEthereumId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSendToEthereumId))
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LastObservedSignerSetTx != nil {
+		l = m.LastObservedSignerSetTx.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedEthereumHeight != nil {
+		l = m.LastObservedEthereumHeight.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.LastSlashedOutgoingTxBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedOutgoingTxBlockHeight))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if m.LastEthereumAddressChangeBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastEthereumAddressChangeBlockHeight))
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20EscrowBalances) > 0 {
+		for _, e := range m.Erc20EscrowBalances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutgoingTxSigningInfos) > 0 {
+		for _, e := range m.OutgoingTxSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedOutgoingTxs) > 0 {
+		for _, e := range m.MissedOutgoingTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Tokens) > 0 {
+		for _, e := range m.Erc20Tokens {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CosmosErc20Approvals) > 0 {
+		for _, e := range m.CosmosErc20Approvals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingErc20Deployments) > 0 {
+		for _, e := range m.PendingErc20Deployments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemappedErc20ToDenoms) > 0 {
+		for _, e := range m.RemappedErc20ToDenoms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastEventNonceByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *ERC20EscrowBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MissedOutgoingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -863,10 +2415,32 @@ func (m *ERC20ToDenom) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
@@ -985,20 +2559,1427 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			}
+			m.SignedSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDeltaAccountingTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceDeltaAccountingTokens = append(m.BalanceDeltaAccountingTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVotePowerThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventVotePowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypePowerThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypePowerThresholds = append(m.EventTypePowerThresholds, EventTypePowerThreshold{})
+			if err := m.EventTypePowerThresholds[len(m.EventTypePowerThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPowerThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositPowerThresholds = append(m.DepositPowerThresholds, DepositPowerThreshold{})
+			if err := m.DepositPowerThresholds[len(m.DepositPowerThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEthereumSigners", wireType)
+			}
+			m.MaxEthereumSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEthereumSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetMaxAge", wireType)
+			}
+			m.SignerSetMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetMinInterval", wireType)
+			}
+			m.SignerSetMinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetMinInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxSigningWindow", wireType)
+			}
+			m.OutgoingTxSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedOutgoingTxsPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedOutgoingTxsPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.OutgoingTxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionOutgoingTxDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionOutgoingTxDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositQuarantineRefundDelay", wireType)
+			}
+			m.DepositQuarantineRefundDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositQuarantineRefundDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnregisteredErc20Policy", wireType)
+			}
+			m.UnregisteredErc20Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnregisteredErc20Policy |= UnregisteredERC20Policy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionUnbondingSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionUnbondingSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTypePowerThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTypePowerThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTypePowerThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositPowerThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositPowerThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositPowerThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
+			}
+			m.LastSendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedSignerSetTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedSignerSetTx == nil {
+				m.LastObservedSignerSetTx = &SignerSetTx{}
+			}
+			if err := m.LastObservedSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			if m.LastObservedEthereumHeight == nil {
+				m.LastObservedEthereumHeight = &LatestEthereumBlockHeight{}
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedOutgoingTxBlockHeight", wireType)
 			}
-			m.BridgeChainId = 0
+			m.LastSlashedOutgoingTxBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1008,16 +3989,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				m.LastSlashedOutgoingTxBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
 			}
-			m.SignedSignerSetTxsWindow = 0
+			m.LastUnbondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1027,16 +4008,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthereumAddressChangeBlockHeight", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			m.LastEthereumAddressChangeBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1046,16 +4027,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				m.LastEthereumAddressChangeBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
 			}
-			m.EthereumSignaturesWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1065,16 +4046,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.TargetEthTxTimeout = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, &LastEventNonceByValidator{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20EscrowBalances", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1084,16 +4080,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20EscrowBalances = append(m.Erc20EscrowBalances, &ERC20EscrowBalance{})
+			if err := m.Erc20EscrowBalances[len(m.Erc20EscrowBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxSigningInfos", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1103,16 +4114,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxSigningInfos = append(m.OutgoingTxSigningInfos, &OutgoingTxSigningInfo{})
+			if err := m.OutgoingTxSigningInfos[len(m.OutgoingTxSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedOutgoingTxs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1122,16 +4148,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedOutgoingTxs = append(m.MissedOutgoingTxs, &MissedOutgoingTxs{})
+			if err := m.MissedOutgoingTxs[len(m.MissedOutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1141,30 +4182,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, &QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Tokens", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1174,30 +4216,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Tokens = append(m.Erc20Tokens, &ERC20TokenMetadata{})
+			if err := m.Erc20Tokens[len(m.Erc20Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosErc20Approvals", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1207,30 +4250,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CosmosErc20Approvals = append(m.CosmosErc20Approvals, &CosmosERC20Approval{})
+			if err := m.CosmosErc20Approvals[len(m.CosmosErc20Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingErc20Deployments", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,30 +4284,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingErc20Deployments = append(m.PendingErc20Deployments, &ERC20DeployedEvent{})
+			if err := m.PendingErc20Deployments[len(m.PendingErc20Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemappedErc20ToDenoms", wireType)
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1273,11 +4318,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemappedErc20ToDenoms = append(m.RemappedErc20ToDenoms, &ERC20ToDenom{})
+			if err := m.RemappedErc20ToDenoms[len(m.RemappedErc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1302,7 +4362,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *LastEventNonceByValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1325,17 +4385,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastEventNonceByValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastEventNonceByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1345,33 +4405,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1381,50 +4437,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20EscrowBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20EscrowBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20EscrowBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1434,31 +4509,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1468,31 +4541,84 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedOutgoingTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedOutgoingTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedOutgoingTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1502,31 +4628,158 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1536,31 +4789,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1570,25 +4821,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1614,7 +4863,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,15 +4886,51 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &SendToCosmosEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1673,11 +4958,13 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1705,8 +4992,27 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +56,106 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePowerThresholds(t *testing.T) {
+	token := "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+	specs := map[string]struct {
+		update func(p *Params)
+		expErr bool
+	}{
+		"default": {update: func(p *Params) {}},
+		"overrides": {update: func(p *Params) {
+			p.EventTypePowerThresholds = []EventTypePowerThreshold{{EventType: "gravity.v1.SignerSetTxExecutedEvent", Threshold: sdk.OneDec()}}
+			p.DepositPowerThresholds = []DepositPowerThreshold{
+				{TokenContract: token, Amount: sdk.NewInt(1000), Threshold: sdk.NewDecWithPrec(8, 1)},
+				{TokenContract: token, Amount: sdk.NewInt(10000), Threshold: sdk.NewDecWithPrec(9, 1)},
+			}
+		}},
+		"half":          {update: func(p *Params) { p.EventVotePowerThreshold = sdk.NewDecWithPrec(5, 1) }, expErr: true},
+		"more than all": {update: func(p *Params) { p.EventVotePowerThreshold = sdk.NewDecWithPrec(11, 1) }, expErr: true},
+		"unset":         {update: func(p *Params) { p.EventVotePowerThreshold = sdk.Dec{} }, expErr: true},
+		"not an event type": {update: func(p *Params) {
+			p.EventTypePowerThresholds = []EventTypePowerThreshold{{EventType: "gravity.v1.SignerSetTx", Threshold: sdk.OneDec()}}
+		}, expErr: true},
+		"duplicate event type": {update: func(p *Params) {
+			p.EventTypePowerThresholds = []EventTypePowerThreshold{
+				{EventType: "gravity.v1.SendToCosmosEvent", Threshold: sdk.OneDec()},
+				{EventType: "gravity.v1.SendToCosmosEvent", Threshold: sdk.NewDecWithPrec(9, 1)},
+			}
+		}, expErr: true},
+		"invalid token": {update: func(p *Params) {
+			p.DepositPowerThresholds = []DepositPowerThreshold{{TokenContract: "0x1", Amount: sdk.NewInt(1000), Threshold: sdk.OneDec()}}
+		}, expErr: true},
+		"zero amount": {update: func(p *Params) {
+			p.DepositPowerThresholds = []DepositPowerThreshold{{TokenContract: token, Amount: sdk.ZeroInt(), Threshold: sdk.OneDec()}}
+		}, expErr: true},
+		"duplicate deposit tier": {update: func(p *Params) {
+			p.DepositPowerThresholds = []DepositPowerThreshold{
+				{TokenContract: token, Amount: sdk.NewInt(1000), Threshold: sdk.OneDec()},
+				{TokenContract: token, Amount: sdk.NewInt(1000), Threshold: sdk.NewDecWithPrec(9, 1)},
+			}
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := DefaultParams()
+			spec.update(p)
+			err := p.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSlashFractionUnbondingSignerSetTx(t *testing.T) {
+	specs := map[string]struct {
+		fraction sdk.Dec
+		expErr   bool
+	}{
+		"default":       {fraction: DefaultSlashFractionUnbondingSignerSetTx},
+		"zero":          {fraction: sdk.ZeroDec()},
+		"all":           {fraction: sdk.OneDec()},
+		"more than all": {fraction: sdk.NewDecWithPrec(11, 1), expErr: true},
+		"negative":      {fraction: sdk.NewDecWithPrec(-1, 2), expErr: true},
+		"unset":         {fraction: sdk.Dec{}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := DefaultParams()
+			p.SlashFractionUnbondingSignerSetTx = spec.fraction
+			err := p.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEventVotePowerThresholdOf(t *testing.T) {
+	token := "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+	p := DefaultParams()
+	p.EventTypePowerThresholds = []EventTypePowerThreshold{
+		{EventType: "gravity.v1.SignerSetTxExecutedEvent", Threshold: sdk.OneDec()},
+		{EventType: "gravity.v1.SendToCosmosEvent", Threshold: sdk.NewDecWithPrec(7, 1)},
+	}
+	p.DepositPowerThresholds = []DepositPowerThreshold{
+		{TokenContract: token, Amount: sdk.NewInt(10000), Threshold: sdk.NewDecWithPrec(9, 1)},
+		{TokenContract: token, Amount: sdk.NewInt(1000), Threshold: sdk.NewDecWithPrec(8, 1)},
+		{TokenContract: token, Amount: sdk.NewInt(100), Threshold: sdk.NewDecWithPrec(6, 1)},
+	}
+	deposit := func(token string, amount int64) *SendToCosmosEvent {
+		return &SendToCosmosEvent{TokenContract: token, Amount: sdk.NewInt(amount)}
+	}
+
+	require.Equal(t, DefaultEventVotePowerThreshold, p.EventVotePowerThresholdOf(&BatchExecutedEvent{}))
+	require.Equal(t, sdk.OneDec(), p.EventVotePowerThresholdOf(&SignerSetTxExecutedEvent{}))
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), p.EventVotePowerThresholdOf(deposit(token, 999)))
+	require.Equal(t, sdk.NewDecWithPrec(8, 1), p.EventVotePowerThresholdOf(deposit(token, 1000)))
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), p.EventVotePowerThresholdOf(deposit(token, 20000)))
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), p.EventVotePowerThresholdOf(deposit("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", 20000)))
+}
@@ -10,18 +10,21 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -568,6 +571,138 @@ func (m *ERC20Token) GetContract() string {
 	return ""
 }
 
+// ERC20TokenMetadata is the token registry entry of an ethereum originated
+// ERC20 token. The vouchers of allowed tokens get bank denom metadata.
+type ERC20TokenMetadata struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals      uint64 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Allowed       bool   `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *ERC20TokenMetadata) Reset()         { *m = ERC20TokenMetadata{} }
+func (m *ERC20TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*ERC20TokenMetadata) ProtoMessage()    {}
+func (*ERC20TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{8}
+}
+func (m *ERC20TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20TokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20TokenMetadata.Merge(m, src)
+}
+func (m *ERC20TokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20TokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20TokenMetadata proto.InternalMessageInfo
+
+func (m *ERC20TokenMetadata) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ERC20TokenMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ERC20TokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ERC20TokenMetadata) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *ERC20TokenMetadata) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+// CosmosERC20Approval approves the deployment of token_contract as the ERC20
+// representation of a cosmos originated denom
+type CosmosERC20Approval struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+}
+
+func (m *CosmosERC20Approval) Reset()         { *m = CosmosERC20Approval{} }
+func (m *CosmosERC20Approval) String() string { return proto.CompactTextString(m) }
+func (*CosmosERC20Approval) ProtoMessage()    {}
+func (*CosmosERC20Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{9}
+}
+func (m *CosmosERC20Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosERC20Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosERC20Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosERC20Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosERC20Approval.Merge(m, src)
+}
+func (m *CosmosERC20Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosERC20Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosERC20Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosERC20Approval proto.InternalMessageInfo
+
+func (m *CosmosERC20Approval) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CosmosERC20Approval) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
 type IDSet struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -576,7 +711,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{8}
+	return fileDescriptor_1715a041eadeb531, []int{10}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,6 +747,218 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
+// SignerSetTxDecision is whether a new signer set tx is created at a block
+// height and why
+type SignerSetTxDecision struct {
+	BlockHeight             uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	LatestSignerSetTxNonce  uint64 `protobuf:"varint,2,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LatestSignerSetTxHeight uint64 `protobuf:"varint,3,opt,name=latest_signer_set_tx_height,json=latestSignerSetTxHeight,proto3" json:"latest_signer_set_tx_height,omitempty"`
+	// power_diff is the power change between the current signer set and the
+	// latest signer set tx
+	PowerDiff github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=power_diff,json=powerDiff,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_diff"`
+	// no_signer_set_tx is true if there is no signer set tx yet
+	NoSignerSetTx bool `protobuf:"varint,5,opt,name=no_signer_set_tx,json=noSignerSetTx,proto3" json:"no_signer_set_tx,omitempty"`
+	// validator_unbonding is true if a validator started unbonding since the
+	// latest signer set tx
+	ValidatorUnbonding bool `protobuf:"varint,6,opt,name=validator_unbonding,json=validatorUnbonding,proto3" json:"validator_unbonding,omitempty"`
+	// power_changed is true if the power diff is above the threshold
+	PowerChanged bool `protobuf:"varint,7,opt,name=power_changed,json=powerChanged,proto3" json:"power_changed,omitempty"`
+	// members_changed is true if a validator moved in or out of a capped
+	// signer set
+	MembersChanged bool `protobuf:"varint,8,opt,name=members_changed,json=membersChanged,proto3" json:"members_changed,omitempty"`
+	// ethereum_address_changed is true if a validator of the signer set changed
+	// its ethereum address since the latest signer set tx
+	EthereumAddressChanged bool `protobuf:"varint,9,opt,name=ethereum_address_changed,json=ethereumAddressChanged,proto3" json:"ethereum_address_changed,omitempty"`
+	// expired is true if the latest signer set tx is older than the max age
+	Expired bool `protobuf:"varint,10,opt,name=expired,proto3" json:"expired,omitempty"`
+	// too_soon is true if the latest signer set tx is more recent than the min
+	// interval
+	TooSoon bool `protobuf:"varint,11,opt,name=too_soon,json=tooSoon,proto3" json:"too_soon,omitempty"`
+	Create  bool `protobuf:"varint,12,opt,name=create,proto3" json:"create,omitempty"`
+}
+
+func (m *SignerSetTxDecision) Reset()         { *m = SignerSetTxDecision{} }
+func (m *SignerSetTxDecision) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxDecision) ProtoMessage()    {}
+func (*SignerSetTxDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{11}
+}
+func (m *SignerSetTxDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetTxDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxDecision.Merge(m, src)
+}
+func (m *SignerSetTxDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxDecision proto.InternalMessageInfo
+
+func (m *SignerSetTxDecision) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SignerSetTxDecision) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
+	}
+	return 0
+}
+
+func (m *SignerSetTxDecision) GetLatestSignerSetTxHeight() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxHeight
+	}
+	return 0
+}
+
+func (m *SignerSetTxDecision) GetNoSignerSetTx() bool {
+	if m != nil {
+		return m.NoSignerSetTx
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetValidatorUnbonding() bool {
+	if m != nil {
+		return m.ValidatorUnbonding
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetPowerChanged() bool {
+	if m != nil {
+		return m.PowerChanged
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetMembersChanged() bool {
+	if m != nil {
+		return m.MembersChanged
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetEthereumAddressChanged() bool {
+	if m != nil {
+		return m.EthereumAddressChanged
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetTooSoon() bool {
+	if m != nil {
+		return m.TooSoon
+	}
+	return false
+}
+
+func (m *SignerSetTxDecision) GetCreate() bool {
+	if m != nil {
+		return m.Create
+	}
+	return false
+}
+
+// OutgoingTxSigningInfo counts the outgoing txs a validator missed over the
+// outgoing tx signing window
+type OutgoingTxSigningInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// index_offset is the number of outgoing txs the validator had to sign
+	// since it was last jailed for missing too many
+	IndexOffset              uint64    `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedOutgoingTxsCounter uint64    `protobuf:"varint,3,opt,name=missed_outgoing_txs_counter,json=missedOutgoingTxsCounter,proto3" json:"missed_outgoing_txs_counter,omitempty"`
+	JailedUntil              time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *OutgoingTxSigningInfo) Reset()         { *m = OutgoingTxSigningInfo{} }
+func (m *OutgoingTxSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxSigningInfo) ProtoMessage()    {}
+func (*OutgoingTxSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{12}
+}
+func (m *OutgoingTxSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxSigningInfo.Merge(m, src)
+}
+func (m *OutgoingTxSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxSigningInfo proto.InternalMessageInfo
+
+func (m *OutgoingTxSigningInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OutgoingTxSigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *OutgoingTxSigningInfo) GetMissedOutgoingTxsCounter() uint64 {
+	if m != nil {
+		return m.MissedOutgoingTxsCounter
+	}
+	return 0
+}
+
+func (m *OutgoingTxSigningInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
 	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
@@ -621,67 +968,97 @@ func init() {
 	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
 	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*ERC20TokenMetadata)(nil), "gravity.v1.ERC20TokenMetadata")
+	proto.RegisterType((*CosmosERC20Approval)(nil), "gravity.v1.CosmosERC20Approval")
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+	proto.RegisterType((*SignerSetTxDecision)(nil), "gravity.v1.SignerSetTxDecision")
+	proto.RegisterType((*OutgoingTxSigningInfo)(nil), "gravity.v1.OutgoingTxSigningInfo")
 }
 
 func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
 
 var fileDescriptor_1715a041eadeb531 = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xae, 0xf3, 0xaf, 0xed, 0xa4, 0xcd, 0x6e, 0xe7, 0x57, 0xed, 0xcf, 0xed, 0x21, 0x89, 0x82,
-	0x80, 0x70, 0xa8, 0xdd, 0x86, 0x3d, 0x2c, 0x87, 0x45, 0x5a, 0x97, 0x5d, 0x6d, 0x25, 0x84, 0x84,
-	0x13, 0x71, 0xe0, 0x12, 0x8d, 0xed, 0xb7, 0xce, 0xd0, 0xc4, 0x63, 0x79, 0x26, 0x21, 0xf9, 0x02,
-	0x1c, 0x38, 0xf1, 0x39, 0x38, 0xf3, 0x0d, 0xb8, 0xac, 0x38, 0xed, 0x11, 0x71, 0x28, 0xd0, 0x7e,
-	0x07, 0x0e, 0x9c, 0xd0, 0xfc, 0xcb, 0xda, 0x2b, 0x04, 0x9c, 0x3a, 0xcf, 0xf3, 0xbe, 0xef, 0x33,
-	0x8f, 0x1f, 0xbf, 0x75, 0x90, 0x9b, 0x16, 0x64, 0x45, 0xc5, 0xc6, 0x5f, 0x5d, 0xf8, 0xe6, 0xe8,
-	0xe5, 0x05, 0x13, 0x0c, 0x23, 0x0b, 0x57, 0x17, 0xa7, 0x27, 0x31, 0xe3, 0x0b, 0xc6, 0xa7, 0xaa,
-	0xe2, 0x6b, 0xa0, 0xdb, 0x4e, 0x7b, 0x29, 0x63, 0xe9, 0x1c, 0x7c, 0x85, 0xa2, 0xe5, 0xb5, 0x2f,
-	0xe8, 0x02, 0xb8, 0x20, 0x8b, 0xdc, 0x34, 0x1c, 0xa7, 0x2c, 0x65, 0x7a, 0x50, 0x9e, 0x0c, 0xdb,
-	0xd5, 0x22, 0x7e, 0x44, 0x38, 0xf8, 0xab, 0x8b, 0x08, 0x04, 0xb9, 0xf0, 0x63, 0x46, 0x33, 0x53,
-	0x3f, 0x79, 0x5b, 0x96, 0x64, 0xc6, 0xd8, 0xe0, 0x5b, 0x07, 0xfd, 0xff, 0xb9, 0x98, 0x41, 0x01,
-	0xcb, 0xc5, 0xf3, 0x15, 0x64, 0xe2, 0x0b, 0x26, 0x20, 0x84, 0x98, 0x15, 0x09, 0x7e, 0x8a, 0x9a,
-	0x20, 0x29, 0xd7, 0xe9, 0x3b, 0xc3, 0xf6, 0xe8, 0xd8, 0xd3, 0x32, 0x9e, 0x95, 0xf1, 0x9e, 0x65,
-	0x9b, 0xe0, 0xe8, 0xa7, 0x1f, 0xce, 0x0e, 0x2b, 0x0a, 0xa1, 0x9e, 0xc2, 0xc7, 0xa8, 0xb9, 0x62,
-	0x02, 0xb8, 0x5b, 0xeb, 0xd7, 0x87, 0xfb, 0xa1, 0x06, 0xf8, 0x14, 0xed, 0x91, 0x38, 0x86, 0x5c,
-	0x40, 0xe2, 0xd6, 0xfb, 0xce, 0x70, 0x2f, 0xdc, 0xe2, 0x01, 0x45, 0x27, 0x9f, 0x12, 0x01, 0x5c,
-	0x58, 0xbd, 0x60, 0xce, 0xe2, 0x9b, 0x97, 0x40, 0xd3, 0x99, 0xc0, 0xef, 0xa3, 0x07, 0x60, 0xe8,
-	0xe9, 0x4c, 0x51, 0xca, 0x57, 0x23, 0xec, 0x58, 0xda, 0x34, 0xbe, 0x83, 0x0e, 0x4d, 0xc2, 0xa6,
-	0xad, 0xa6, 0xda, 0x0e, 0x34, 0xa9, 0x9b, 0x06, 0x9f, 0xa3, 0x8e, 0xbd, 0x64, 0x4c, 0xd3, 0x0c,
-	0x0a, 0x69, 0x37, 0x67, 0x5f, 0x43, 0x61, 0x54, 0x35, 0xc0, 0x1f, 0xa0, 0x87, 0xdb, 0x5b, 0x49,
-	0x92, 0x14, 0xc0, 0xb9, 0xd2, 0xdb, 0x0f, 0xb7, 0x6e, 0x9e, 0x69, 0x7a, 0xf0, 0x8d, 0x83, 0xda,
-	0x5a, 0x6b, 0x0c, 0x62, 0xb2, 0x96, 0x82, 0x19, 0xcb, 0x62, 0xb0, 0x82, 0x0a, 0xe0, 0x47, 0xa8,
-	0x55, 0xb1, 0x65, 0x10, 0xbe, 0x42, 0xbb, 0x5c, 0x0d, 0x73, 0xb7, 0xde, 0xaf, 0x0f, 0xdb, 0xa3,
-	0x53, 0xef, 0xcd, 0xce, 0x78, 0x55, 0xaf, 0xc1, 0xff, 0xbe, 0xff, 0xb5, 0xf7, 0xa0, 0xca, 0xf1,
-	0xd0, 0xce, 0x0f, 0x7e, 0x74, 0xd0, 0x6e, 0x40, 0x44, 0x3c, 0x9b, 0xac, 0x71, 0x0f, 0xb5, 0x23,
-	0x79, 0x9c, 0x96, 0xad, 0x20, 0x45, 0x7d, 0xa6, 0xfc, 0xb8, 0x68, 0x57, 0x2e, 0x19, 0x5b, 0x5a,
-	0x43, 0x16, 0xe2, 0x8f, 0xd1, 0x81, 0x28, 0x48, 0xc6, 0x49, 0x2c, 0x28, 0xcb, 0xfe, 0xd6, 0xd6,
-	0x18, 0xb2, 0x64, 0xc2, 0xac, 0x91, 0xb0, 0xd2, 0x8f, 0xdf, 0x45, 0x1d, 0xc1, 0x6e, 0x20, 0x9b,
-	0xc6, 0x2c, 0x13, 0x05, 0x89, 0x85, 0xdb, 0x50, 0xc1, 0x1d, 0x2a, 0xf6, 0xd2, 0x90, 0xa5, 0x40,
-	0x9a, 0xe5, 0x40, 0x06, 0xbf, 0x3b, 0xa8, 0x53, 0xd5, 0xc7, 0x1d, 0x54, 0xa3, 0x89, 0x79, 0x86,
-	0x1a, 0x4d, 0xe4, 0x28, 0x87, 0x2c, 0x81, 0xc2, 0xbc, 0x12, 0x83, 0xf0, 0x19, 0xc2, 0xdb, 0x97,
-	0x56, 0x40, 0x4c, 0x73, 0x2a, 0xb7, 0xb8, 0xae, 0x7a, 0x8e, 0x6c, 0x25, 0xb4, 0x05, 0xfc, 0x14,
-	0xb5, 0xa1, 0x88, 0x47, 0xe7, 0x53, 0x65, 0x4c, 0xb9, 0x6c, 0x8f, 0x1e, 0x55, 0xe2, 0x0f, 0x2f,
-	0x47, 0xe7, 0x13, 0x59, 0x0d, 0x1a, 0xaf, 0x6e, 0x7b, 0x3b, 0x21, 0x52, 0x03, 0x8a, 0xc1, 0x1f,
-	0xa1, 0x7d, 0x3d, 0x7e, 0x0d, 0xa0, 0x9e, 0xe1, 0xdf, 0x86, 0xf7, 0x54, 0xfb, 0x0b, 0x80, 0xc1,
-	0x1f, 0x35, 0xd4, 0xb1, 0x41, 0x5c, 0x92, 0xf9, 0x7c, 0xb2, 0x96, 0xde, 0x69, 0xb6, 0x22, 0x73,
-	0x9a, 0x10, 0x19, 0x63, 0xe5, 0xbd, 0x1d, 0x95, 0x2b, 0xfa, 0xf5, 0xa5, 0x6f, 0xb5, 0xf3, 0x98,
-	0xe5, 0xa0, 0xe2, 0x38, 0x08, 0x9e, 0xfc, 0x79, 0xdb, 0x7b, 0x9c, 0x52, 0x31, 0x5b, 0x46, 0x5e,
-	0xcc, 0x16, 0xbe, 0x50, 0xe9, 0x2c, 0x68, 0x26, 0xca, 0xc7, 0x39, 0x8d, 0xb8, 0x1f, 0x6d, 0x04,
-	0x70, 0xef, 0x25, 0xac, 0x03, 0x79, 0xa8, 0x5e, 0x34, 0x96, 0x92, 0x72, 0x4f, 0xec, 0xfe, 0xeb,
-	0x20, 0x2d, 0x94, 0x95, 0x9c, 0x6c, 0xe6, 0x8c, 0x24, 0x2a, 0xba, 0x83, 0xd0, 0xc2, 0xf2, 0x6e,
-	0x35, 0xab, 0xbb, 0xf5, 0x18, 0xb5, 0x54, 0xd8, 0xdc, 0x6d, 0xa9, 0xad, 0xfa, 0xe7, 0xc0, 0x4c,
-	0x2f, 0x3e, 0x47, 0x8d, 0x6b, 0x00, 0xee, 0xee, 0xfe, 0x87, 0x19, 0xd5, 0x59, 0x5a, 0xae, 0xbd,
-	0xca, 0x72, 0xe5, 0x08, 0xbd, 0x99, 0x90, 0xdf, 0xa4, 0xed, 0x8e, 0x3a, 0xea, 0xe1, 0xb6, 0x18,
-	0xbf, 0x40, 0x2d, 0xb2, 0x60, 0xcb, 0x4c, 0xff, 0x7b, 0xec, 0x07, 0x9e, 0x54, 0xff, 0xe5, 0xb6,
-	0xf7, 0x5e, 0x29, 0x58, 0xf3, 0xf9, 0xd5, 0x7f, 0xce, 0x78, 0x72, 0xe3, 0x8b, 0x4d, 0x0e, 0xdc,
-	0xbb, 0xca, 0x44, 0x68, 0xa6, 0x07, 0x27, 0xa8, 0x79, 0xf5, 0xc9, 0x18, 0x04, 0x7e, 0x88, 0xea,
-	0x34, 0xe1, 0xae, 0xd3, 0xaf, 0x0f, 0x1b, 0xa1, 0x3c, 0x06, 0xe1, 0xab, 0xbb, 0xae, 0xf3, 0xfa,
-	0xae, 0xeb, 0xfc, 0x76, 0xd7, 0x75, 0xbe, 0xbb, 0xef, 0xee, 0xbc, 0xbe, 0xef, 0xee, 0xfc, 0x7c,
-	0xdf, 0xdd, 0xf9, 0xf2, 0x49, 0xe9, 0x92, 0x1c, 0xd2, 0x74, 0xf3, 0xd5, 0xca, 0xfe, 0xb0, 0x9c,
-	0x45, 0x05, 0x4d, 0x52, 0xf0, 0x17, 0x2c, 0x59, 0xce, 0xc1, 0x5f, 0x5b, 0x5e, 0x5f, 0x1d, 0xb5,
-	0xd4, 0x47, 0xfa, 0xc3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x32, 0xe5, 0xf6, 0xc4, 0x93, 0x06,
-	0x00, 0x00,
+	// 1274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0xcf, 0xc6, 0x4e, 0xe2, 0x8c, 0x9d, 0x34, 0x99, 0x94, 0x74, 0x93, 0x4a, 0x76, 0x30, 0x82,
+	0x06, 0xa1, 0x78, 0x9b, 0xd0, 0x43, 0x41, 0x14, 0xa9, 0x4e, 0x5a, 0x1a, 0x89, 0x52, 0xb1, 0x71,
+	0x39, 0x70, 0x59, 0xcd, 0xee, 0x7e, 0xde, 0x4c, 0xbb, 0x3b, 0x63, 0xed, 0x8c, 0x5d, 0xfb, 0x05,
+	0x38, 0x70, 0xea, 0x0b, 0xf0, 0x02, 0x9c, 0x38, 0xf0, 0x06, 0x5c, 0x2a, 0x4e, 0x3d, 0x22, 0x0e,
+	0x2d, 0xb4, 0x0f, 0xc0, 0x8d, 0x03, 0x27, 0x34, 0x7f, 0xd6, 0xf1, 0xa6, 0x91, 0x28, 0x27, 0xcf,
+	0xf7, 0xe7, 0xf7, 0xcd, 0xcf, 0xdf, 0xfc, 0x66, 0xbe, 0x45, 0x6e, 0x92, 0x93, 0x11, 0x95, 0x13,
+	0x6f, 0xb4, 0xef, 0xd9, 0x65, 0x67, 0x90, 0x73, 0xc9, 0x31, 0x2a, 0xcc, 0xd1, 0xfe, 0xf6, 0x56,
+	0xc4, 0x45, 0xc6, 0x45, 0xa0, 0x23, 0x9e, 0x31, 0x4c, 0xda, 0x76, 0x2b, 0xe1, 0x3c, 0x49, 0xc1,
+	0xd3, 0x56, 0x38, 0xec, 0x7b, 0x92, 0x66, 0x20, 0x24, 0xc9, 0x06, 0x36, 0xe1, 0x72, 0xc2, 0x13,
+	0x6e, 0x80, 0x6a, 0x65, 0xbd, 0x4d, 0x53, 0xc4, 0x0b, 0x89, 0x00, 0x6f, 0xb4, 0x1f, 0x82, 0x24,
+	0xfb, 0x5e, 0xc4, 0x29, 0xb3, 0xf1, 0xad, 0xf3, 0x65, 0x09, 0xb3, 0xc4, 0xda, 0xdf, 0x3b, 0xe8,
+	0xca, 0x1d, 0x79, 0x0a, 0x39, 0x0c, 0xb3, 0x3b, 0x23, 0x60, 0xf2, 0x1b, 0x2e, 0xc1, 0x87, 0x88,
+	0xe7, 0x31, 0xbe, 0x85, 0x16, 0x40, 0xb9, 0x5c, 0x67, 0xc7, 0xd9, 0xad, 0x1f, 0x5c, 0xee, 0x98,
+	0x32, 0x9d, 0xa2, 0x4c, 0xe7, 0x36, 0x9b, 0x74, 0xd7, 0x7f, 0xfd, 0x79, 0x6f, 0xa5, 0x54, 0xc1,
+	0x37, 0x28, 0x7c, 0x19, 0x2d, 0x8c, 0xb8, 0x04, 0xe1, 0xce, 0xef, 0x54, 0x76, 0x97, 0x7d, 0x63,
+	0xe0, 0x6d, 0x54, 0x23, 0x51, 0x04, 0x03, 0x09, 0xb1, 0x5b, 0xd9, 0x71, 0x76, 0x6b, 0xfe, 0xd4,
+	0x6e, 0x53, 0xb4, 0xf5, 0x25, 0x91, 0x20, 0x64, 0x51, 0xaf, 0x9b, 0xf2, 0xe8, 0xf1, 0x3d, 0xa0,
+	0xc9, 0xa9, 0xc4, 0xd7, 0xd0, 0x25, 0xb0, 0xee, 0xe0, 0x54, 0xbb, 0x34, 0xaf, 0xaa, 0xbf, 0x5a,
+	0xb8, 0x6d, 0xe2, 0x7b, 0x68, 0xc5, 0x76, 0xd8, 0xa6, 0xcd, 0xeb, 0xb4, 0x86, 0x71, 0x9a, 0xa4,
+	0xf6, 0xd7, 0x68, 0xb5, 0xd8, 0xe4, 0x84, 0x26, 0x0c, 0x72, 0x45, 0x77, 0xc0, 0x9f, 0x40, 0x6e,
+	0xab, 0x1a, 0x03, 0x7f, 0x88, 0xd6, 0xa6, 0xbb, 0x92, 0x38, 0xce, 0x41, 0x08, 0x5d, 0x6f, 0xd9,
+	0x9f, 0xb2, 0xb9, 0x6d, 0xdc, 0xed, 0xef, 0x1c, 0x54, 0x37, 0xb5, 0x4e, 0x40, 0xf6, 0xc6, 0xaa,
+	0x20, 0xe3, 0x2c, 0x82, 0xa2, 0xa0, 0x36, 0xf0, 0x26, 0x5a, 0x2c, 0xd1, 0xb2, 0x16, 0x3e, 0x46,
+	0x4b, 0x42, 0x83, 0x85, 0x5b, 0xd9, 0xa9, 0xec, 0xd6, 0x0f, 0xb6, 0x3b, 0x67, 0x9a, 0xe9, 0x94,
+	0xb9, 0x76, 0x37, 0x7e, 0x7c, 0xd9, 0xba, 0x54, 0xf6, 0x09, 0xbf, 0xc0, 0xb7, 0x7f, 0x71, 0xd0,
+	0x52, 0x97, 0xc8, 0xe8, 0xb4, 0x37, 0xc6, 0x2d, 0x54, 0x0f, 0xd5, 0x32, 0x98, 0xa5, 0x82, 0xb4,
+	0xeb, 0x2b, 0xcd, 0xc7, 0x45, 0x4b, 0x4a, 0x64, 0x7c, 0x58, 0x10, 0x2a, 0x4c, 0xfc, 0x39, 0x6a,
+	0xc8, 0x9c, 0x30, 0x41, 0x22, 0x49, 0x39, 0xbb, 0x90, 0xd6, 0x09, 0xb0, 0xb8, 0xc7, 0x0b, 0x22,
+	0x7e, 0x29, 0x1f, 0xbf, 0x8f, 0x56, 0x25, 0x7f, 0x0c, 0x2c, 0x88, 0x38, 0x93, 0x39, 0x89, 0xa4,
+	0x5b, 0xd5, 0x8d, 0x5b, 0xd1, 0xde, 0x43, 0xeb, 0x9c, 0x69, 0xc8, 0xc2, 0x6c, 0x43, 0xda, 0x7f,
+	0x3a, 0x68, 0xb5, 0x5c, 0x1f, 0xaf, 0xa2, 0x79, 0x1a, 0xdb, 0xff, 0x30, 0x4f, 0x63, 0x05, 0x15,
+	0xc0, 0x62, 0xc8, 0xed, 0x91, 0x58, 0x0b, 0xef, 0x21, 0x3c, 0x3d, 0xb4, 0x1c, 0x22, 0x3a, 0xa0,
+	0x4a, 0xc5, 0x15, 0x9d, 0xb3, 0x5e, 0x44, 0xfc, 0x22, 0x80, 0x6f, 0xa1, 0x3a, 0xe4, 0xd1, 0xc1,
+	0xf5, 0x40, 0x13, 0xd3, 0x2c, 0xeb, 0x07, 0x9b, 0xa5, 0xf6, 0xfb, 0x87, 0x07, 0xd7, 0x7b, 0x2a,
+	0xda, 0xad, 0x3e, 0x7b, 0xd1, 0x9a, 0xf3, 0x91, 0x06, 0x68, 0x0f, 0xfe, 0x04, 0x2d, 0x1b, 0x78,
+	0x1f, 0xc0, 0x5d, 0x78, 0x0b, 0x70, 0x4d, 0xa7, 0xdf, 0x05, 0x68, 0xff, 0x3d, 0x8f, 0x56, 0x8b,
+	0x46, 0x1c, 0x92, 0x34, 0xed, 0x8d, 0x15, 0x77, 0xca, 0x46, 0x24, 0xa5, 0x31, 0x51, 0x6d, 0x2c,
+	0x9d, 0xdb, 0xfa, 0x6c, 0xc4, 0x1c, 0x5f, 0x72, 0x2e, 0x5d, 0x44, 0x7c, 0x00, 0xba, 0x1d, 0x8d,
+	0xee, 0xcd, 0x7f, 0x5e, 0xb4, 0x6e, 0x24, 0x54, 0x9e, 0x0e, 0xc3, 0x4e, 0xc4, 0x33, 0x4f, 0xea,
+	0xee, 0x64, 0x94, 0xc9, 0xd9, 0x65, 0x4a, 0x43, 0xe1, 0x85, 0x13, 0x09, 0xa2, 0x73, 0x0f, 0xc6,
+	0x5d, 0xb5, 0x28, 0x6f, 0x74, 0xa2, 0x4a, 0x2a, 0x9d, 0x14, 0xfa, 0x37, 0x8d, 0x2c, 0x4c, 0x15,
+	0x19, 0x90, 0x49, 0xca, 0x49, 0xac, 0x5b, 0xd7, 0xf0, 0x0b, 0x73, 0x56, 0x5b, 0x0b, 0x65, 0x6d,
+	0xdd, 0x40, 0x8b, 0xba, 0xd9, 0xc2, 0x5d, 0xdc, 0xa9, 0xfc, 0x67, 0xc3, 0x6c, 0x2e, 0xbe, 0x8e,
+	0xaa, 0x7d, 0x00, 0xe1, 0x2e, 0xbd, 0x05, 0x46, 0x67, 0xce, 0x88, 0xab, 0x56, 0x12, 0xd7, 0x00,
+	0xa1, 0x33, 0x84, 0x7a, 0x93, 0xa6, 0x1a, 0x75, 0xf4, 0x9f, 0x9b, 0xda, 0xf8, 0x2e, 0x5a, 0x24,
+	0x19, 0x1f, 0x32, 0x73, 0x3d, 0x96, 0xbb, 0x1d, 0x55, 0xfd, 0xf7, 0x17, 0xad, 0x0f, 0x66, 0x1a,
+	0x6b, 0x9f, 0x5f, 0xf3, 0xb3, 0x27, 0xe2, 0xc7, 0x9e, 0x9c, 0x0c, 0x40, 0x74, 0x8e, 0x99, 0xf4,
+	0x2d, 0xba, 0xfd, 0x83, 0x83, 0xf0, 0xd9, 0x96, 0xf7, 0x41, 0x92, 0x98, 0x48, 0x72, 0xc1, 0x25,
+	0x71, 0x2e, 0xba, 0x24, 0x18, 0x55, 0x19, 0xc9, 0xc0, 0xea, 0x5c, 0xaf, 0xb5, 0xfa, 0x27, 0x59,
+	0xc8, 0x53, 0x7b, 0x20, 0xd6, 0x52, 0xff, 0x26, 0x86, 0x88, 0x66, 0x24, 0x15, 0xfa, 0x40, 0xaa,
+	0xfe, 0xd4, 0xd6, 0xa7, 0x98, 0xa6, 0xfc, 0x09, 0xc4, 0xfa, 0x44, 0x6a, 0x7e, 0x61, 0xb6, 0x7d,
+	0xb4, 0x71, 0xa8, 0xf9, 0x6b, 0x92, 0xb7, 0x07, 0x83, 0x9c, 0x8f, 0x48, 0xaa, 0x1e, 0xb1, 0x18,
+	0x18, 0xcf, 0x2c, 0x2d, 0x63, 0x5c, 0xc0, 0x7a, 0xfe, 0x02, 0xd6, 0xed, 0x2d, 0xb4, 0x70, 0x7c,
+	0x74, 0x02, 0x12, 0xaf, 0xa1, 0x0a, 0x8d, 0x85, 0xeb, 0xec, 0x54, 0x76, 0xab, 0xbe, 0x5a, 0xb6,
+	0x7f, 0xaa, 0xa2, 0x8d, 0x99, 0xc7, 0xf2, 0x08, 0x22, 0x2a, 0x28, 0x67, 0xf8, 0x5d, 0xd4, 0x08,
+	0xd5, 0xa3, 0x5f, 0x7e, 0xe2, 0xeb, 0xe1, 0xcc, 0x20, 0xf8, 0x14, 0x6d, 0xa7, 0x7a, 0x4a, 0x04,
+	0xe6, 0xc1, 0x0b, 0x04, 0xc8, 0x40, 0x8e, 0xed, 0x4d, 0x31, 0x8f, 0xd8, 0xa6, 0xc9, 0x98, 0xd9,
+	0xc1, 0x5c, 0x97, 0xcf, 0xd0, 0xd5, 0x0b, 0xb1, 0x76, 0xb7, 0x8a, 0x06, 0x5f, 0x79, 0x03, 0x6c,
+	0x77, 0xbe, 0x8f, 0x90, 0x9e, 0x0a, 0x41, 0x4c, 0xfb, 0x7d, 0xb7, 0xfa, 0xbf, 0xf5, 0x70, 0x04,
+	0x91, 0xbf, 0xac, 0x2b, 0x1c, 0xd1, 0x7e, 0x1f, 0x5f, 0x43, 0x6b, 0x8c, 0x97, 0x89, 0xd8, 0x53,
+	0x59, 0x61, 0x7c, 0x76, 0x92, 0x78, 0x68, 0xc3, 0x5e, 0x47, 0x9e, 0x07, 0x43, 0x16, 0x72, 0x16,
+	0x53, 0x96, 0xb8, 0x8b, 0x3a, 0x17, 0x4f, 0x43, 0x0f, 0x8b, 0x88, 0x1a, 0x81, 0x86, 0x68, 0x74,
+	0x4a, 0x58, 0x02, 0xb1, 0xbb, 0xa4, 0x53, 0x1b, 0xda, 0x79, 0x68, 0x7c, 0x6a, 0xa0, 0x66, 0x90,
+	0x85, 0x90, 0x8b, 0x69, 0x5a, 0x4d, 0xa7, 0xad, 0x5a, 0x77, 0x91, 0x78, 0x13, 0xb9, 0xe7, 0x67,
+	0xe0, 0x14, 0xb1, 0xac, 0x11, 0x9b, 0xe7, 0x66, 0x61, 0x81, 0x74, 0xd1, 0x12, 0x8c, 0x07, 0x34,
+	0x87, 0xd8, 0x45, 0x46, 0x6e, 0xd6, 0xc4, 0x5b, 0xa8, 0x26, 0x39, 0x0f, 0x04, 0xe7, 0xcc, 0xad,
+	0x9b, 0x90, 0xe4, 0xfc, 0x84, 0x73, 0xa6, 0x74, 0x1d, 0xe5, 0x40, 0x24, 0xb8, 0x0d, 0x1d, 0xb0,
+	0x56, 0xfb, 0x2f, 0x07, 0xbd, 0xf3, 0x60, 0x28, 0x13, 0x4e, 0x59, 0xd2, 0x1b, 0xab, 0xfe, 0x50,
+	0x96, 0x1c, 0xb3, 0x3e, 0xc7, 0x1f, 0xa1, 0xf5, 0xb3, 0xfe, 0x14, 0xaf, 0x94, 0x11, 0xec, 0xda,
+	0x34, 0x60, 0xa9, 0x29, 0x85, 0x51, 0x16, 0xc3, 0x38, 0xe0, 0xfd, 0xbe, 0x80, 0x62, 0xea, 0xd5,
+	0xb5, 0xef, 0x81, 0x76, 0xe1, 0x5b, 0xe8, 0x6a, 0x46, 0x85, 0x80, 0x38, 0xe0, 0x76, 0xbf, 0x40,
+	0x8e, 0x45, 0x10, 0xa9, 0x8b, 0x0c, 0xb9, 0x55, 0x89, 0x6b, 0x52, 0xce, 0x18, 0x89, 0x43, 0x13,
+	0xc7, 0x5f, 0xa0, 0xc6, 0x23, 0x42, 0x53, 0x88, 0x83, 0x21, 0x93, 0x34, 0xb5, 0x03, 0x65, 0xfb,
+	0x8d, 0xcf, 0xa7, 0x5e, 0xf1, 0x71, 0xd7, 0xad, 0x29, 0x11, 0x3d, 0x7d, 0xd9, 0x72, 0xfc, 0xba,
+	0x41, 0x3e, 0x54, 0xc0, 0xae, 0xff, 0xec, 0x55, 0xd3, 0x79, 0xfe, 0xaa, 0xe9, 0xfc, 0xf1, 0xaa,
+	0xe9, 0x3c, 0x7d, 0xdd, 0x9c, 0x7b, 0xfe, 0xba, 0x39, 0xf7, 0xdb, 0xeb, 0xe6, 0xdc, 0xb7, 0x37,
+	0x67, 0xd4, 0x36, 0x80, 0x24, 0x99, 0x3c, 0x1a, 0x15, 0x5f, 0x9c, 0x7b, 0x61, 0x4e, 0xe3, 0x04,
+	0xbc, 0x8c, 0xc7, 0xc3, 0x14, 0xbc, 0x71, 0xe1, 0x37, 0x1a, 0x0c, 0x17, 0xf5, 0xf6, 0x1f, 0xff,
+	0x3b, 0x00, 0xdb, 0x4d, 0xb4, 0x18, 0xac, 0x0a, 0x00, 0x00,
 }
 
 func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
@@ -1101,7 +1478,7 @@ func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IDSet) Marshal() (dAtA []byte, err error) {
+func (m *ERC20TokenMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1111,56 +1488,328 @@ func (m *IDSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *ERC20TokenMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ERC20TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGravity(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGravity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CosmosERC20Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EthereumEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
+
+func (m *CosmosERC20Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosERC20Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA5 := make([]byte, len(m.Ids)*10)
+		var j4 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGravity(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Create {
+		i--
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TooSoon {
+		i--
+		if m.TooSoon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumAddressChanged {
+		i--
+		if m.EthereumAddressChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MembersChanged {
+		i--
+		if m.MembersChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PowerChanged {
+		i--
+		if m.PowerChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValidatorUnbonding {
+		i--
+		if m.ValidatorUnbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NoSignerSetTx {
+		i--
+		if m.NoSignerSetTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PowerDiff.Size()
+		i -= size
+		if _, err := m.PowerDiff.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LatestSignerSetTxHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LatestSignerSetTxHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGravity(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.MissedOutgoingTxsCounter != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.MissedOutgoingTxsCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGravity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthereumEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
 		n += 1 + l + sovGravity(uint64(l))
 	}
 	if len(m.Votes) > 0 {
@@ -1336,6 +1985,50 @@ func (m *ERC20Token) Size() (n int) {
 	return n
 }
 
+func (m *ERC20TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGravity(uint64(m.Decimals))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *CosmosERC20Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
 func (m *IDSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1352,17 +2045,82 @@ func (m *IDSet) Size() (n int) {
 	return n
 }
 
-func sovGravity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGravity(x uint64) (n int) {
-	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *SignerSetTxDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGravity(uint64(m.BlockHeight))
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 1 + sovGravity(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LatestSignerSetTxHeight != 0 {
+		n += 1 + sovGravity(uint64(m.LatestSignerSetTxHeight))
+	}
+	l = m.PowerDiff.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.NoSignerSetTx {
+		n += 2
+	}
+	if m.ValidatorUnbonding {
+		n += 2
+	}
+	if m.PowerChanged {
+		n += 2
+	}
+	if m.MembersChanged {
+		n += 2
+	}
+	if m.EthereumAddressChanged {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.TooSoon {
+		n += 2
+	}
+	if m.Create {
+		n += 2
+	}
+	return n
+}
+
+func (m *OutgoingTxSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovGravity(uint64(m.IndexOffset))
+	}
+	if m.MissedOutgoingTxsCounter != 0 {
+		n += 1 + sovGravity(uint64(m.MissedOutgoingTxsCounter))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func sovGravity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGravity(x uint64) (n int) {
+	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2594,7 +3352,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
+func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,88 +3375,853 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20TokenMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGravity
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGravity
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGravity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
 			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosERC20Approval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosERC20Approval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosERC20Approval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxHeight", wireType)
+			}
+			m.LatestSignerSetTxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoSignerSetTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoSignerSetTx = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorUnbonding = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PowerChanged = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MembersChanged = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddressChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EthereumAddressChanged = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TooSoon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TooSoon = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTxSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTxSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTxSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedOutgoingTxsCounter", wireType)
+			}
+			m.MissedOutgoingTxsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedOutgoingTxsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
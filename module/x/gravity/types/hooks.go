@@ -2,12 +2,36 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+// GravityHooks are called by the gravity keeper at the lifecycle points of
+// ethereum events and outgoing txs. A Before hook returning an error vetoes
+// the action.
 type GravityHooks interface {
 	AfterContractCallExecutedEvent(ctx sdk.Context, event ContractCallExecutedEvent)
 	AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent)
 	AfterSignerSetExecutedEvent(ctx sdk.Context, event SignerSetTxExecutedEvent)
 	AfterBatchExecutedEvent(ctx sdk.Context, event BatchExecutedEvent)
 	AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent)
+
+	// BeforeSendToEthereum is called before a send to ethereum is added to
+	// the unbatched pool, a veto fails the message sending it
+	BeforeSendToEthereum(ctx sdk.Context, ste SendToEthereum) error
+	AfterSendToEthereum(ctx sdk.Context, ste SendToEthereum)
+	// BeforeCancelSendToEthereum is called before an unbatched send to
+	// ethereum is cancelled, a veto fails the cancellation
+	BeforeCancelSendToEthereum(ctx sdk.Context, ste SendToEthereum) error
+	AfterCancelSendToEthereum(ctx sdk.Context, ste SendToEthereum)
+	// BeforeBatchTxCreated is called before the sends to ethereum of a batch
+	// are taken from the unbatched pool, a veto leaves them in the pool
+	BeforeBatchTxCreated(ctx sdk.Context, batch BatchTx) error
+	AfterBatchTxCreated(ctx sdk.Context, batch BatchTx)
+	// AfterBatchTxTimedOut is called once a batch timed out on ethereum and
+	// its sends to ethereum are back in the unbatched pool. A timed out
+	// batch can't be executed anymore, so there is no veto.
+	AfterBatchTxTimedOut(ctx sdk.Context, batch BatchTx)
+	// BeforeSignerSetTxCreated is called before a signer set tx is stored, a
+	// veto defers it to the next block which decides to create one
+	BeforeSignerSetTxCreated(ctx sdk.Context, signerSetTx SignerSetTx) error
+	AfterSignerSetTxCreated(ctx sdk.Context, signerSetTx SignerSetTx)
 }
 
 type MultiGravityHooks []GravityHooks
@@ -45,3 +69,69 @@ func (mghs MultiGravityHooks) AfterSendToCosmosEvent(ctx sdk.Context, event Send
 		mghs[i].AfterSendToCosmosEvent(ctx, event)
 	}
 }
+
+func (mghs MultiGravityHooks) BeforeSendToEthereum(ctx sdk.Context, ste SendToEthereum) error {
+	for i := range mghs {
+		if err := mghs[i].BeforeSendToEthereum(ctx, ste); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterSendToEthereum(ctx sdk.Context, ste SendToEthereum) {
+	for i := range mghs {
+		mghs[i].AfterSendToEthereum(ctx, ste)
+	}
+}
+
+func (mghs MultiGravityHooks) BeforeCancelSendToEthereum(ctx sdk.Context, ste SendToEthereum) error {
+	for i := range mghs {
+		if err := mghs[i].BeforeCancelSendToEthereum(ctx, ste); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterCancelSendToEthereum(ctx sdk.Context, ste SendToEthereum) {
+	for i := range mghs {
+		mghs[i].AfterCancelSendToEthereum(ctx, ste)
+	}
+}
+
+func (mghs MultiGravityHooks) BeforeBatchTxCreated(ctx sdk.Context, batch BatchTx) error {
+	for i := range mghs {
+		if err := mghs[i].BeforeBatchTxCreated(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterBatchTxCreated(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		mghs[i].AfterBatchTxCreated(ctx, batch)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterBatchTxTimedOut(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		mghs[i].AfterBatchTxTimedOut(ctx, batch)
+	}
+}
+
+func (mghs MultiGravityHooks) BeforeSignerSetTxCreated(ctx sdk.Context, signerSetTx SignerSetTx) error {
+	for i := range mghs {
+		if err := mghs[i].BeforeSignerSetTxCreated(ctx, signerSetTx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterSignerSetTxCreated(ctx sdk.Context, signerSetTx SignerSetTx) {
+	for i := range mghs {
+		mghs[i].AfterSignerSetTxCreated(ctx, signerSetTx)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -59,6 +60,48 @@ const (
 	LastUnBondingBlockHeightKey
 
 	LastObservedSignerSetKey
+
+	// ERC20EscrowBalanceKey indexes the escrow balance of balance delta accounting tokens
+	ERC20EscrowBalanceKey
+
+	// EthereumValidatorAddressKey is the reverse index of ValidatorEthereumAddressKey
+	EthereumValidatorAddressKey
+
+	// OrchestratorEthereumAddressKey is the reverse index of EthereumOrchestratorAddressKey
+	OrchestratorEthereumAddressKey
+
+	// SendToEthereumIDKey indexes the pool key of an unbatched send to ethereum by id
+	SendToEthereumIDKey
+
+	// SendToEthereumSenderKey indexes the ids of the unbatched send to ethereums of a sender
+	SendToEthereumSenderKey
+
+	// LastOutgoingBatchNonceByTokenKey indexes the nonce of the latest batch of a token
+	LastOutgoingBatchNonceByTokenKey
+
+	// LastEthereumAddressChangeBlockHeightKey indexes the block height of the last ethereum address change of a validator
+	LastEthereumAddressChangeBlockHeightKey
+
+	// OutgoingTxSigningInfoKey indexes the outgoing tx signing info of a validator
+	OutgoingTxSigningInfoKey
+
+	// MissedOutgoingTxBitKey indexes the missed outgoing txs of a validator by their index in the signing window
+	MissedOutgoingTxBitKey
+
+	// QuarantinedDepositKey indexes the deposits which failed to credit their cosmos receiver by event nonce
+	QuarantinedDepositKey
+
+	// ERC20TokenMetadataKey indexes the token registry entries of ethereum originated ERC20 tokens by token contract
+	ERC20TokenMetadataKey
+
+	// CosmosERC20ApprovalKey indexes the approved ERC20 representations of cosmos originated denoms by denom
+	CosmosERC20ApprovalKey
+
+	// PendingERC20DeploymentKey indexes the observed deployments of ERC20 representations which aren't approved by token contract
+	PendingERC20DeploymentKey
+
+	// PendingERC20DeploymentDenomKey indexes the token contracts of the pending deployments of a denom by event nonce
+	PendingERC20DeploymentDenomKey
 )
 
 ////////////////////
@@ -86,6 +129,20 @@ func MakeEthereumOrchestratorAddressKey(eth common.Address) []byte {
 	return append([]byte{EthereumOrchestratorAddressKey}, eth.Bytes()...)
 }
 
+// MakeEthereumValidatorAddressKey returns the following key format
+// prefix   eth-address                                 cosmos-validator
+// [0x15][0xc783df8a850f42e7F7e57013759C285caa701eB6][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumValidatorAddressKey(eth common.Address, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{EthereumValidatorAddressKey}, eth.Bytes(), validator.Bytes()}, []byte{})
+}
+
+// MakeOrchestratorEthereumAddressKey returns the following key format
+// prefix   orchestrator                                   eth-address
+// [0x16][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeOrchestratorEthereumAddressKey(orc sdk.AccAddress, eth common.Address) []byte {
+	return bytes.Join([][]byte{{OrchestratorEthereumAddressKey}, address.MustLengthPrefix(orc.Bytes()), eth.Bytes()}, []byte{})
+}
+
 /////////////////////////
 // Etheruem Signatures //
 /////////////////////////
@@ -129,6 +186,20 @@ func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeSendToEthereumIDKey returns the following key format
+// prefix   id
+// [0x17][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumIDKey(id uint64) []byte {
+	return append([]byte{SendToEthereumIDKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumSenderKey returns the following key format
+// prefix   sender                                         id
+// [0x18][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumSenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return bytes.Join([][]byte{{SendToEthereumSenderKey}, address.MustLengthPrefix(sender.Bytes()), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
@@ -145,10 +216,77 @@ func MakeERC20ToDenomKey(erc20 string) []byte {
 	return append([]byte{ERC20ToDenomKey}, []byte(erc20)...)
 }
 
+func MakeERC20EscrowBalanceKey(tokenContract common.Address) []byte {
+	return append([]byte{ERC20EscrowBalanceKey}, tokenContract.Bytes()...)
+}
+
 func MakeSignerSetTxKey(nonce uint64) []byte {
 	return append([]byte{SignerSetTxPrefixByte}, sdk.Uint64ToBigEndian(nonce)...)
 }
 
+// MakeLastOutgoingBatchNonceByTokenKey returns the following key format
+// prefix   eth-contract-address
+// [0x19][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeLastOutgoingBatchNonceByTokenKey(token common.Address) []byte {
+	return append([]byte{LastOutgoingBatchNonceByTokenKey}, token.Bytes()...)
+}
+
+// MakeOutgoingTxSigningInfoKey returns the following key format
+// prefix   cosmos-validator
+// [0x1b][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeOutgoingTxSigningInfoKey(validator sdk.ValAddress) []byte {
+	return append([]byte{OutgoingTxSigningInfoKey}, validator.Bytes()...)
+}
+
+// MakeMissedOutgoingTxBitPrefix returns the following key format
+// prefix   cosmos-validator
+// [0x1c][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeMissedOutgoingTxBitPrefix(validator sdk.ValAddress) []byte {
+	return append([]byte{MissedOutgoingTxBitKey}, address.MustLengthPrefix(validator.Bytes())...)
+}
+
+// MakeMissedOutgoingTxBitKey returns the following key format
+// prefix   cosmos-validator                                        index
+// [0x1c][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func MakeMissedOutgoingTxBitKey(validator sdk.ValAddress, index uint64) []byte {
+	return append(MakeMissedOutgoingTxBitPrefix(validator), sdk.Uint64ToBigEndian(index)...)
+}
+
+// MakeQuarantinedDepositKey returns the following key format
+// prefix   nonce
+// [0x1d][0 0 0 0 0 0 0 1]
+func MakeQuarantinedDepositKey(eventNonce uint64) []byte {
+	return append([]byte{QuarantinedDepositKey}, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// MakeERC20TokenMetadataKey returns the following key format
+// prefix   erc20
+// [0x1e][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeERC20TokenMetadataKey(tokenContract common.Address) []byte {
+	return append([]byte{ERC20TokenMetadataKey}, tokenContract.Bytes()...)
+}
+
+// MakeCosmosERC20ApprovalKey returns the following key format
+// prefix   denom
+// [0x1f][ugraviton]
+func MakeCosmosERC20ApprovalKey(denom string) []byte {
+	return append([]byte{CosmosERC20ApprovalKey}, []byte(denom)...)
+}
+
+// MakePendingERC20DeploymentKey returns the following key format
+// prefix   erc20
+// [0x20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakePendingERC20DeploymentKey(tokenContract common.Address) []byte {
+	return append([]byte{PendingERC20DeploymentKey}, tokenContract.Bytes()...)
+}
+
+// MakePendingERC20DeploymentDenomKey returns the following key format
+// prefix   denom        event nonce        erc20
+// [0x21][ugraviton][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakePendingERC20DeploymentDenomKey(denom string, eventNonce uint64, tokenContract common.Address) []byte {
+	return bytes.Join([][]byte{{PendingERC20DeploymentDenomKey}, address.MustLengthPrefix([]byte(denom)), sdk.Uint64ToBigEndian(eventNonce), tokenContract.Bytes()}, []byte{})
+}
+
 func MakeBatchTxKey(addr common.Address, nonce uint64) []byte {
 	return bytes.Join([][]byte{{BatchTxPrefixByte}, addr.Bytes(), sdk.Uint64ToBigEndian(nonce)}, []byte{})
 }
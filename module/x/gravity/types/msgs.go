@@ -9,16 +9,35 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// MaxSendToEthereumEntries is the maximum number of entries of a
+// MsgSendToEthereumMulti, which are all added to the unbatched pool in one tx
+const MaxSendToEthereumEntries = 100
+
+// MaxEthereumEventsPerMsg is the maximum number of events of a
+// MsgSubmitEthereumEvents. Orchestrator messages are exempt from fees, so the
+// work a single message can carry is bounded.
+const MaxEthereumEventsPerMsg = 100
+
+// MaxEthereumTxConfirmationsPerMsg is the maximum number of confirmations of a
+// MsgSubmitEthereumTxConfirmations, bounded for the same reason
+const MaxEthereumTxConfirmationsPerMsg = 100
+
 var (
 	_ sdk.Msg = &MsgDelegateKeys{}
 	_ sdk.Msg = &MsgSendToEthereum{}
+	_ sdk.Msg = &MsgSendToEthereumMulti{}
 	_ sdk.Msg = &MsgCancelSendToEthereum{}
 	_ sdk.Msg = &MsgRequestBatchTx{}
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
+	_ sdk.Msg = &MsgSubmitEthereumEvents{}
+	_ sdk.Msg = &MsgSubmitEthereumTxConfirmations{}
+	_ sdk.Msg = &MsgResolveQuarantinedDeposit{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvents{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmations{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
 )
 
@@ -150,6 +169,125 @@ func (msg *MsgSubmitEthereumTxConfirmation) UnpackInterfaces(unpacker cdctypes.A
 	return unpacker.UnpackAny(msg.Confirmation, &sig)
 }
 
+// Route should return the name of the module
+func (msg *MsgSubmitEthereumEvents) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitEthereumEvents) Type() string { return "submit_ethereum_events" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitEthereumEvents) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Events) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no events")
+	}
+	if len(msg.Events) > MaxEthereumEventsPerMsg {
+		return sdkerrors.Wrapf(ErrInvalid, "%d events, more than %d", len(msg.Events), MaxEthereumEventsPerMsg)
+	}
+
+	var lastNonce uint64
+	for i, any := range msg.Events {
+		event, err := UnpackEvent(any)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "event %d", i)
+		}
+		if err := event.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "event %d", i)
+		}
+		if i > 0 && event.GetEventNonce() != lastNonce+1 {
+			return sdkerrors.Wrapf(ErrInvalid, "non contiguous event nonce %d after %d", event.GetEventNonce(), lastNonce)
+		}
+		lastNonce = event.GetEventNonce()
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitEthereumEvents) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitEthereumEvents) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitEthereumEvents) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, any := range msg.Events {
+		var event EthereumEvent
+		if err := unpacker.UnpackAny(any, &event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitEthereumTxConfirmations) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitEthereumTxConfirmations) Type() string { return "submit_ethereum_signatures" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitEthereumTxConfirmations) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Confirmations) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no confirmations")
+	}
+	if len(msg.Confirmations) > MaxEthereumTxConfirmationsPerMsg {
+		return sdkerrors.Wrapf(ErrInvalid, "%d confirmations, more than %d", len(msg.Confirmations), MaxEthereumTxConfirmationsPerMsg)
+	}
+
+	seen := make(map[string]bool, len(msg.Confirmations))
+	for i, any := range msg.Confirmations {
+		confirmation, err := UnpackConfirmation(any)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "confirmation %d", i)
+		}
+		if err := confirmation.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "confirmation %d", i)
+		}
+		storeIndex := string(confirmation.GetStoreIndex())
+		if seen[storeIndex] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate confirmation %d", i)
+		}
+		seen[storeIndex] = true
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitEthereumTxConfirmations) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitEthereumTxConfirmations) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitEthereumTxConfirmations) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, any := range msg.Confirmations {
+		var sig EthereumTxConfirmation
+		if err := unpacker.UnpackAny(any, &sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMsgSendToEthereum returns a new MsgSendToEthereum
 func NewMsgSendToEthereum(sender sdk.AccAddress, destAddress string, send sdk.Coin, bridgeFee sdk.Coin) *MsgSendToEthereum {
 	return &MsgSendToEthereum{
@@ -208,6 +346,85 @@ func (msg MsgSendToEthereum) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgSendToEthereumMulti returns a new MsgSendToEthereumMulti
+func NewMsgSendToEthereumMulti(sender sdk.AccAddress, entries []SendToEthereumEntry) *MsgSendToEthereumMulti {
+	return &MsgSendToEthereumMulti{
+		Sender:  sender.String(),
+		Entries: entries,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSendToEthereumMulti) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSendToEthereumMulti) Type() string { return "send_to_eth_multi" }
+
+// ValidateBasic runs stateless checks on the message
+// Checks that all entries are valid and of the same denom
+func (msg MsgSendToEthereumMulti) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if len(msg.Entries) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no entries")
+	}
+	if len(msg.Entries) > MaxSendToEthereumEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "%d entries, more than %d", len(msg.Entries), MaxSendToEthereumEntries)
+	}
+
+	for i, entry := range msg.Entries {
+		if err := entry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		// all entries are escrowed or burned at once
+		if entry.Amount.Denom != msg.Entries[0].Amount.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins,
+				"entry %d: all entries must be the same type %s != %s", i, entry.Amount.Denom, msg.Entries[0].Amount.Denom)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSendToEthereumMulti) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSendToEthereumMulti) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic runs the same stateless checks on the entry as
+// MsgSendToEthereum does
+func (entry SendToEthereumEntry) ValidateBasic() error {
+	// fee and send must be of the same denom
+	// this check is VERY IMPORTANT
+	if entry.Amount.Denom != entry.BridgeFee.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins,
+			fmt.Sprintf("fee and amount must be the same type %s != %s", entry.Amount.Denom, entry.BridgeFee.Denom))
+	}
+
+	if !entry.Amount.IsValid() || entry.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	if !entry.BridgeFee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	if !common.IsHexAddress(entry.EthereumRecipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+
+	return nil
+}
+
 // NewMsgRequestBatchTx returns a new msgRequestBatch
 func NewMsgRequestBatchTx(denom string, signer sdk.AccAddress) *MsgRequestBatchTx {
 	return &MsgRequestBatchTx{
@@ -248,6 +465,56 @@ func (msg MsgRequestBatchTx) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgResolveQuarantinedDeposit returns a new MsgResolveQuarantinedDeposit
+func NewMsgResolveQuarantinedDeposit(eventNonce uint64, cosmosReceiver string, ethSig []byte, signer sdk.AccAddress) *MsgResolveQuarantinedDeposit {
+	return &MsgResolveQuarantinedDeposit{
+		EventNonce:        eventNonce,
+		CosmosReceiver:    cosmosReceiver,
+		EthereumSignature: ethSig,
+		Signer:            signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgResolveQuarantinedDeposit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgResolveQuarantinedDeposit) Type() string { return "resolve_quarantined_deposit" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgResolveQuarantinedDeposit) ValidateBasic() error {
+	if msg.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce cannot be 0")
+	}
+	if msg.CosmosReceiver != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.CosmosReceiver); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosReceiver)
+		}
+	}
+	if len(msg.EthereumSignature) == 0 {
+		return ErrEmptyEthSig
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResolveQuarantinedDeposit) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResolveQuarantinedDeposit) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
 // NewMsgCancelSendToEthereum returns a new MsgCancelSendToEthereum
 func NewMsgCancelSendToEthereum(id uint64, orchestrator sdk.AccAddress) *MsgCancelSendToEthereum {
 	return &MsgCancelSendToEthereum{
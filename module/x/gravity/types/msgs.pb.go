@@ -151,6 +151,169 @@ func (m *MsgSendToEthereumResponse) GetId() uint64 {
 	return 0
 }
 
+// MsgSendToEthereumMulti submits many SendToEthereums of the same token at
+// once. The vouchers for all entries are escrowed or burned together and every
+// entry is stored as its own SendToEthereum, to be batched like the ones
+// submitted with MsgSendToEthereum.
+type MsgSendToEthereumMulti struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Entries []SendToEthereumEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MsgSendToEthereumMulti) Reset()         { *m = MsgSendToEthereumMulti{} }
+func (m *MsgSendToEthereumMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgSendToEthereumMulti) ProtoMessage()    {}
+func (*MsgSendToEthereumMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
+}
+func (m *MsgSendToEthereumMulti) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendToEthereumMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendToEthereumMulti.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendToEthereumMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendToEthereumMulti.Merge(m, src)
+}
+func (m *MsgSendToEthereumMulti) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendToEthereumMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendToEthereumMulti.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendToEthereumMulti proto.InternalMessageInfo
+
+func (m *MsgSendToEthereumMulti) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSendToEthereumMulti) GetEntries() []SendToEthereumEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// SendToEthereumEntry is a single transfer of a MsgSendToEthereumMulti
+type SendToEthereumEntry struct {
+	EthereumRecipient string     `protobuf:"bytes,1,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	BridgeFee         types.Coin `protobuf:"bytes,3,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+}
+
+func (m *SendToEthereumEntry) Reset()         { *m = SendToEthereumEntry{} }
+func (m *SendToEthereumEntry) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumEntry) ProtoMessage()    {}
+func (*SendToEthereumEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
+}
+func (m *SendToEthereumEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendToEthereumEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumEntry.Merge(m, src)
+}
+func (m *SendToEthereumEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToEthereumEntry proto.InternalMessageInfo
+
+func (m *SendToEthereumEntry) GetEthereumRecipient() string {
+	if m != nil {
+		return m.EthereumRecipient
+	}
+	return ""
+}
+
+func (m *SendToEthereumEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SendToEthereumEntry) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+// MsgSendToEthereumMultiResponse returns the SendToEthereum transaction IDs in
+// the order of the entries
+type MsgSendToEthereumMultiResponse struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *MsgSendToEthereumMultiResponse) Reset()         { *m = MsgSendToEthereumMultiResponse{} }
+func (m *MsgSendToEthereumMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendToEthereumMultiResponse) ProtoMessage()    {}
+func (*MsgSendToEthereumMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendToEthereumMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendToEthereumMultiResponse.Merge(m, src)
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendToEthereumMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendToEthereumMultiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendToEthereumMultiResponse proto.InternalMessageInfo
+
+func (m *MsgSendToEthereumMultiResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 // MsgCancelSendToEthereum allows the sender to cancel its own outgoing
 // SendToEthereum tx and recieve a refund of the tokens and bridge fees. This tx
 // will only succeed if the SendToEthereum tx hasn't been batched to be
@@ -164,7 +327,7 @@ func (m *MsgCancelSendToEthereum) Reset()         { *m = MsgCancelSendToEthereum
 func (m *MsgCancelSendToEthereum) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthereum) ProtoMessage()    {}
 func (*MsgCancelSendToEthereum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgCancelSendToEthereum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +377,7 @@ func (m *MsgCancelSendToEthereumResponse) Reset()         { *m = MsgCancelSendTo
 func (m *MsgCancelSendToEthereumResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthereumResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthereumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgCancelSendToEthereumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +417,7 @@ func (m *MsgRequestBatchTx) Reset()         { *m = MsgRequestBatchTx{} }
 func (m *MsgRequestBatchTx) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTx) ProtoMessage()    {}
 func (*MsgRequestBatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgRequestBatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +467,7 @@ func (m *MsgRequestBatchTxResponse) Reset()         { *m = MsgRequestBatchTxResp
 func (m *MsgRequestBatchTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTxResponse) ProtoMessage()    {}
 func (*MsgRequestBatchTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgRequestBatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +508,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Reset()         { *m = MsgSubmitEthere
 func (m *MsgSubmitEthereumTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmation) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *MsgSubmitEthereumTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +550,7 @@ func (m *ContractCallTxConfirmation) Reset()         { *m = ContractCallTxConfir
 func (m *ContractCallTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmation) ProtoMessage()    {}
 func (*ContractCallTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *ContractCallTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +619,7 @@ func (m *BatchTxConfirmation) Reset()         { *m = BatchTxConfirmation{} }
 func (m *BatchTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmation) ProtoMessage()    {}
 func (*BatchTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *BatchTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +688,7 @@ func (m *SignerSetTxConfirmation) Reset()         { *m = SignerSetTxConfirmation
 func (m *SignerSetTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmation) ProtoMessage()    {}
 func (*SignerSetTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *SignerSetTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +747,7 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Reset() {
 func (m *MsgSubmitEthereumTxConfirmationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmationResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgSubmitEthereumTxConfirmationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +786,7 @@ func (m *MsgSubmitEthereumEvent) Reset()         { *m = MsgSubmitEthereumEvent{}
 func (m *MsgSubmitEthereumEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEvent) ProtoMessage()    {}
 func (*MsgSubmitEthereumEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgSubmitEthereumEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +822,7 @@ func (m *MsgSubmitEthereumEventResponse) Reset()         { *m = MsgSubmitEthereu
 func (m *MsgSubmitEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEventResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgSubmitEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,28 +851,25 @@ func (m *MsgSubmitEthereumEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEthereumEventResponse proto.InternalMessageInfo
 
-// MsgDelegateKey allows validators to delegate their voting responsibilities
-// to a given orchestrator address. This key is then used as an optional
-// authentication method for attesting events from Ethereum.
-type MsgDelegateKeys struct {
-	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
-	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
-	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+// MsgSubmitEthereumTxConfirmations submits many ethereum signatures of a
+// validator at once. Either all of them are stored or none.
+type MsgSubmitEthereumTxConfirmations struct {
+	Confirmations []*types1.Any `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Signer        string        `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
-func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
-func (*MsgDelegateKeys) ProtoMessage()    {}
-func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+func (m *MsgSubmitEthereumTxConfirmations) Reset()         { *m = MsgSubmitEthereumTxConfirmations{} }
+func (m *MsgSubmitEthereumTxConfirmations) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumTxConfirmations) ProtoMessage()    {}
+func (*MsgSubmitEthereumTxConfirmations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
-func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDelegateKeys.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitEthereumTxConfirmations.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -719,61 +879,38 @@ func (m *MsgDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgDelegateKeys) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDelegateKeys.Merge(m, src)
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmations.Merge(m, src)
 }
-func (m *MsgDelegateKeys) XXX_Size() int {
+func (m *MsgSubmitEthereumTxConfirmations) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDelegateKeys) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDelegateKeys.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgDelegateKeys proto.InternalMessageInfo
-
-func (m *MsgDelegateKeys) GetValidatorAddress() string {
-	if m != nil {
-		return m.ValidatorAddress
-	}
-	return ""
-}
-
-func (m *MsgDelegateKeys) GetOrchestratorAddress() string {
-	if m != nil {
-		return m.OrchestratorAddress
-	}
-	return ""
+func (m *MsgSubmitEthereumTxConfirmations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmations.DiscardUnknown(m)
 }
 
-func (m *MsgDelegateKeys) GetEthereumAddress() string {
-	if m != nil {
-		return m.EthereumAddress
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSubmitEthereumTxConfirmations proto.InternalMessageInfo
 
-func (m *MsgDelegateKeys) GetEthSignature() []byte {
-	if m != nil {
-		return m.EthSignature
-	}
-	return nil
+// MsgSubmitEthereumTxConfirmationsResponse returns the result of every
+// confirmation in order
+type MsgSubmitEthereumTxConfirmationsResponse struct {
+	Results []EthereumTxConfirmationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-type MsgDelegateKeysResponse struct {
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Reset() {
+	*m = MsgSubmitEthereumTxConfirmationsResponse{}
 }
-
-func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse{} }
-func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDelegateKeysResponse) ProtoMessage()    {}
-func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+func (m *MsgSubmitEthereumTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumTxConfirmationsResponse) ProtoMessage()    {}
+func (*MsgSubmitEthereumTxConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
-func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDelegateKeysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -783,38 +920,43 @@ func (m *MsgDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgDelegateKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDelegateKeysResponse.Merge(m, src)
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.Merge(m, src)
 }
-func (m *MsgDelegateKeysResponse) XXX_Size() int {
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDelegateKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDelegateKeysResponse.DiscardUnknown(m)
+func (m *MsgSubmitEthereumTxConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDelegateKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitEthereumTxConfirmationsResponse proto.InternalMessageInfo
 
-// DelegateKeysSignMsg defines the message structure an operator is expected to
-// sign when submitting a MsgDelegateKeys message. The resulting signature should
-// populate the eth_signature field.
-type DelegateKeysSignMsg struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *MsgSubmitEthereumTxConfirmationsResponse) GetResults() []EthereumTxConfirmationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
-func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysSignMsg) ProtoMessage()    {}
-func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+// EthereumTxConfirmationResult is the stored signature of an outgoing tx
+type EthereumTxConfirmationResult struct {
+	StoreIndex     []byte `protobuf:"bytes,1,opt,name=store_index,json=storeIndex,proto3" json:"store_index,omitempty"`
+	EthereumSigner string `protobuf:"bytes,2,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
 }
-func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+
+func (m *EthereumTxConfirmationResult) Reset()         { *m = EthereumTxConfirmationResult{} }
+func (m *EthereumTxConfirmationResult) String() string { return proto.CompactTextString(m) }
+func (*EthereumTxConfirmationResult) ProtoMessage()    {}
+func (*EthereumTxConfirmationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
+}
+func (m *EthereumTxConfirmationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EthereumTxConfirmationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EthereumTxConfirmationResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -824,56 +966,51 @@ func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+func (m *EthereumTxConfirmationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumTxConfirmationResult.Merge(m, src)
 }
-func (m *DelegateKeysSignMsg) XXX_Size() int {
+func (m *EthereumTxConfirmationResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+func (m *EthereumTxConfirmationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumTxConfirmationResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+var xxx_messageInfo_EthereumTxConfirmationResult proto.InternalMessageInfo
 
-func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
+func (m *EthereumTxConfirmationResult) GetStoreIndex() []byte {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.StoreIndex
 	}
-	return ""
+	return nil
 }
 
-func (m *DelegateKeysSignMsg) GetNonce() uint64 {
+func (m *EthereumTxConfirmationResult) GetEthereumSigner() string {
 	if m != nil {
-		return m.Nonce
+		return m.EthereumSigner
 	}
-	return 0
+	return ""
 }
 
-// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
-// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
-// address.
-type SendToCosmosEvent struct {
-	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	EthereumSender string                                 `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
-	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
-	EthereumHeight uint64                                 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+// MsgSubmitEthereumEvents submits many events of a validator at once, in
+// order of event nonce. Either all of the votes are recorded or none.
+type MsgSubmitEthereumEvents struct {
+	Events []*types1.Any `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Signer string        `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
-func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
-func (*SendToCosmosEvent) ProtoMessage()    {}
-func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+func (m *MsgSubmitEthereumEvents) Reset()         { *m = MsgSubmitEthereumEvents{} }
+func (m *MsgSubmitEthereumEvents) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumEvents) ProtoMessage()    {}
+func (*MsgSubmitEthereumEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
-func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitEthereumEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitEthereumEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SendToCosmosEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitEthereumEvents.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -883,74 +1020,84 @@ func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SendToCosmosEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendToCosmosEvent.Merge(m, src)
+func (m *MsgSubmitEthereumEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumEvents.Merge(m, src)
 }
-func (m *SendToCosmosEvent) XXX_Size() int {
+func (m *MsgSubmitEthereumEvents) XXX_Size() int {
 	return m.Size()
 }
-func (m *SendToCosmosEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendToCosmosEvent.DiscardUnknown(m)
+func (m *MsgSubmitEthereumEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumEvents.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendToCosmosEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitEthereumEvents proto.InternalMessageInfo
 
-func (m *SendToCosmosEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
-	}
-	return 0
+// MsgSubmitEthereumEventsResponse returns the result of every event in order
+type MsgSubmitEthereumEventsResponse struct {
+	Results []EthereumEventVoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *SendToCosmosEvent) GetTokenContract() string {
-	if m != nil {
-		return m.TokenContract
-	}
-	return ""
+func (m *MsgSubmitEthereumEventsResponse) Reset()         { *m = MsgSubmitEthereumEventsResponse{} }
+func (m *MsgSubmitEthereumEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthereumEventsResponse) ProtoMessage()    {}
+func (*MsgSubmitEthereumEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
-
-func (m *SendToCosmosEvent) GetEthereumSender() string {
-	if m != nil {
-		return m.EthereumSender
-	}
-	return ""
+func (m *MsgSubmitEthereumEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SendToCosmosEvent) GetCosmosReceiver() string {
-	if m != nil {
-		return m.CosmosReceiver
+func (m *MsgSubmitEthereumEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthereumEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthereumEventsResponse.Merge(m, src)
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthereumEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthereumEventsResponse.DiscardUnknown(m)
 }
 
-func (m *SendToCosmosEvent) GetEthereumHeight() uint64 {
+var xxx_messageInfo_MsgSubmitEthereumEventsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitEthereumEventsResponse) GetResults() []EthereumEventVoteResult {
 	if m != nil {
-		return m.EthereumHeight
+		return m.Results
 	}
-	return 0
+	return nil
 }
 
-// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
-// bridge contract was executed successfully on ETH
-type BatchExecutedEvent struct {
-	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
-	BatchNonce     uint64 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+// EthereumEventVoteResult is the vote record of an event after the vote of
+// the validator
+type EthereumEventVoteResult struct {
+	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EventHash  []byte `protobuf:"bytes,2,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
+	Votes      uint64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	Accepted   bool   `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
 }
 
-func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
-func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*BatchExecutedEvent) ProtoMessage()    {}
-func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+func (m *EthereumEventVoteResult) Reset()         { *m = EthereumEventVoteResult{} }
+func (m *EthereumEventVoteResult) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventVoteResult) ProtoMessage()    {}
+func (*EthereumEventVoteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
-func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *EthereumEventVoteResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EthereumEventVoteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EthereumEventVoteResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -960,67 +1107,68 @@ func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
+func (m *EthereumEventVoteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventVoteResult.Merge(m, src)
 }
-func (m *BatchExecutedEvent) XXX_Size() int {
+func (m *EthereumEventVoteResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
+func (m *EthereumEventVoteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventVoteResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_EthereumEventVoteResult proto.InternalMessageInfo
 
-func (m *BatchExecutedEvent) GetTokenContract() string {
+func (m *EthereumEventVoteResult) GetEventNonce() uint64 {
 	if m != nil {
-		return m.TokenContract
+		return m.EventNonce
 	}
-	return ""
+	return 0
 }
 
-func (m *BatchExecutedEvent) GetEventNonce() uint64 {
+func (m *EthereumEventVoteResult) GetEventHash() []byte {
 	if m != nil {
-		return m.EventNonce
+		return m.EventHash
 	}
-	return 0
+	return nil
 }
 
-func (m *BatchExecutedEvent) GetEthereumHeight() uint64 {
+func (m *EthereumEventVoteResult) GetVotes() uint64 {
 	if m != nil {
-		return m.EthereumHeight
+		return m.Votes
 	}
 	return 0
 }
 
-func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+func (m *EthereumEventVoteResult) GetAccepted() bool {
 	if m != nil {
-		return m.BatchNonce
+		return m.Accepted
 	}
-	return 0
+	return false
 }
 
-// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
-// investigate?
-type ContractCallExecutedEvent struct {
-	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	EthereumHeight    uint64                                               `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+// MsgDelegateKey allows validators to delegate their voting responsibilities
+// to a given orchestrator address. This key is then used as an optional
+// authentication method for attesting events from Ethereum.
+type MsgDelegateKeys struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
-func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
-func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*ContractCallExecutedEvent) ProtoMessage()    {}
-func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
+func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateKeys) ProtoMessage()    {}
+func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
-func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDelegateKeys.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1030,70 +1178,61 @@ func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
+func (m *MsgDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateKeys.Merge(m, src)
 }
-func (m *ContractCallExecutedEvent) XXX_Size() int {
+func (m *MsgDelegateKeys) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
+func (m *MsgDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateKeys.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgDelegateKeys proto.InternalMessageInfo
 
-func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
+func (m *MsgDelegateKeys) GetValidatorAddress() string {
 	if m != nil {
-		return m.EventNonce
+		return m.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *MsgDelegateKeys) GetOrchestratorAddress() string {
 	if m != nil {
-		return m.InvalidationScope
+		return m.OrchestratorAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
+func (m *MsgDelegateKeys) GetEthereumAddress() string {
 	if m != nil {
-		return m.InvalidationNonce
+		return m.EthereumAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) GetEthereumHeight() uint64 {
+func (m *MsgDelegateKeys) GetEthSignature() []byte {
 	if m != nil {
-		return m.EthereumHeight
+		return m.EthSignature
 	}
-	return 0
+	return nil
 }
 
-// ERC20DeployedEvent is submitted when an ERC20 contract
-// for a Cosmos SDK coin has been deployed on Ethereum.
-type ERC20DeployedEvent struct {
-	EventNonce     uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	CosmosDenom    string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
-	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	Erc20Name      string `protobuf:"bytes,4,opt,name=erc20_name,json=erc20Name,proto3" json:"erc20_name,omitempty"`
-	Erc20Symbol    string `protobuf:"bytes,5,opt,name=erc20_symbol,json=erc20Symbol,proto3" json:"erc20_symbol,omitempty"`
-	Erc20Decimals  uint64 `protobuf:"varint,6,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
-	EthereumHeight uint64 `protobuf:"varint,7,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+type MsgDelegateKeysResponse struct {
 }
 
-func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
-func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
-func (*ERC20DeployedEvent) ProtoMessage()    {}
-func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse{} }
+func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
-func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ERC20DeployedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDelegateKeysResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1103,88 +1242,149 @@ func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ERC20DeployedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20DeployedEvent.Merge(m, src)
+func (m *MsgDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateKeysResponse.Merge(m, src)
 }
-func (m *ERC20DeployedEvent) XXX_Size() int {
+func (m *MsgDelegateKeysResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ERC20DeployedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20DeployedEvent.DiscardUnknown(m)
+func (m *MsgDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ERC20DeployedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgDelegateKeysResponse proto.InternalMessageInfo
 
-func (m *ERC20DeployedEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
+// MsgResolveQuarantinedDeposit credits a quarantined deposit to another
+// cosmos receiver, or sends it back to its ethereum sender if cosmos_receiver
+// is empty. ethereum_signature is the signature of the ethereum sender of the
+// deposit over the DepositResolutionSignMsg.
+type MsgResolveQuarantinedDeposit struct {
+	EventNonce        uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	CosmosReceiver    string `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EthereumSignature []byte `protobuf:"bytes,3,opt,name=ethereum_signature,json=ethereumSignature,proto3" json:"ethereum_signature,omitempty"`
+	Signer            string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgResolveQuarantinedDeposit) Reset()         { *m = MsgResolveQuarantinedDeposit{} }
+func (m *MsgResolveQuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveQuarantinedDeposit) ProtoMessage()    {}
+func (*MsgResolveQuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
+}
+func (m *MsgResolveQuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveQuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveQuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgResolveQuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveQuarantinedDeposit.Merge(m, src)
+}
+func (m *MsgResolveQuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveQuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveQuarantinedDeposit.DiscardUnknown(m)
 }
 
-func (m *ERC20DeployedEvent) GetCosmosDenom() string {
+var xxx_messageInfo_MsgResolveQuarantinedDeposit proto.InternalMessageInfo
+
+func (m *MsgResolveQuarantinedDeposit) GetEventNonce() uint64 {
 	if m != nil {
-		return m.CosmosDenom
+		return m.EventNonce
 	}
-	return ""
+	return 0
 }
 
-func (m *ERC20DeployedEvent) GetTokenContract() string {
+func (m *MsgResolveQuarantinedDeposit) GetCosmosReceiver() string {
 	if m != nil {
-		return m.TokenContract
+		return m.CosmosReceiver
 	}
 	return ""
 }
 
-func (m *ERC20DeployedEvent) GetErc20Name() string {
+func (m *MsgResolveQuarantinedDeposit) GetEthereumSignature() []byte {
 	if m != nil {
-		return m.Erc20Name
+		return m.EthereumSignature
 	}
-	return ""
+	return nil
 }
 
-func (m *ERC20DeployedEvent) GetErc20Symbol() string {
+func (m *MsgResolveQuarantinedDeposit) GetSigner() string {
 	if m != nil {
-		return m.Erc20Symbol
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *ERC20DeployedEvent) GetErc20Decimals() uint64 {
-	if m != nil {
-		return m.Erc20Decimals
-	}
-	return 0
+type MsgResolveQuarantinedDepositResponse struct {
 }
 
-func (m *ERC20DeployedEvent) GetEthereumHeight() uint64 {
-	if m != nil {
-		return m.EthereumHeight
+func (m *MsgResolveQuarantinedDepositResponse) Reset()         { *m = MsgResolveQuarantinedDepositResponse{} }
+func (m *MsgResolveQuarantinedDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveQuarantinedDepositResponse) ProtoMessage()    {}
+func (*MsgResolveQuarantinedDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
+}
+func (m *MsgResolveQuarantinedDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveQuarantinedDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveQuarantinedDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgResolveQuarantinedDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveQuarantinedDepositResponse.Merge(m, src)
+}
+func (m *MsgResolveQuarantinedDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveQuarantinedDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveQuarantinedDepositResponse.DiscardUnknown(m)
 }
 
-// This informs the Cosmos module that a validator
-// set has been updated.
-type SignerSetTxExecutedEvent struct {
-	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
-	EthereumHeight   uint64            `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
-	Members          []*EthereumSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+var xxx_messageInfo_MsgResolveQuarantinedDepositResponse proto.InternalMessageInfo
+
+// DepositResolutionSignMsg defines the message structure the ethereum sender
+// of a quarantined deposit is expected to sign when submitting a
+// MsgResolveQuarantinedDeposit message. The gravity id and the bridge contract
+// address keep the signature from being replayed on another deployment.
+type DepositResolutionSignMsg struct {
+	EventNonce            uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	CosmosReceiver        string `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	GravityId             string `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	BridgeEthereumAddress string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
 }
 
-func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
-func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
-func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+func (m *DepositResolutionSignMsg) Reset()         { *m = DepositResolutionSignMsg{} }
+func (m *DepositResolutionSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DepositResolutionSignMsg) ProtoMessage()    {}
+func (*DepositResolutionSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *DepositResolutionSignMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DepositResolutionSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DepositResolutionSignMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1194,1005 +1394,1097 @@ func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
+func (m *DepositResolutionSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositResolutionSignMsg.Merge(m, src)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+func (m *DepositResolutionSignMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
+func (m *DepositResolutionSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositResolutionSignMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_DepositResolutionSignMsg proto.InternalMessageInfo
 
-func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+func (m *DepositResolutionSignMsg) GetEventNonce() uint64 {
 	if m != nil {
 		return m.EventNonce
 	}
 	return 0
 }
 
-func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+func (m *DepositResolutionSignMsg) GetCosmosReceiver() string {
 	if m != nil {
-		return m.SignerSetTxNonce
+		return m.CosmosReceiver
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetEthereumHeight() uint64 {
+func (m *DepositResolutionSignMsg) GetGravityId() string {
 	if m != nil {
-		return m.EthereumHeight
+		return m.GravityId
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetMembers() []*EthereumSigner {
+func (m *DepositResolutionSignMsg) GetBridgeEthereumAddress() string {
 	if m != nil {
-		return m.Members
+		return m.BridgeEthereumAddress
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgSendToEthereum)(nil), "gravity.v1.MsgSendToEthereum")
-	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
-	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
-	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
-	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
-	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
-	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
-	proto.RegisterType((*ContractCallTxConfirmation)(nil), "gravity.v1.ContractCallTxConfirmation")
-	proto.RegisterType((*BatchTxConfirmation)(nil), "gravity.v1.BatchTxConfirmation")
-	proto.RegisterType((*SignerSetTxConfirmation)(nil), "gravity.v1.SignerSetTxConfirmation")
-	proto.RegisterType((*MsgSubmitEthereumTxConfirmationResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationResponse")
-	proto.RegisterType((*MsgSubmitEthereumEvent)(nil), "gravity.v1.MsgSubmitEthereumEvent")
-	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
-	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
-	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
-	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
-	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
-	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
-	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
-	proto.RegisterType((*ERC20DeployedEvent)(nil), "gravity.v1.ERC20DeployedEvent")
-	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "gravity.v1.SignerSetTxExecutedEvent")
+// DelegateKeysSignMsg defines the message structure an operator is expected to
+// sign when submitting a MsgDelegateKeys message. The resulting signature should
+// populate the eth_signature field.
+type DelegateKeysSignMsg struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
-
-var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0xd9, 0x81, 0x47, 0xfe, 0xa4, 0xfd, 0x26, 0xb2, 0x92, 0x48, 0x8e, 0x82, 0xbc,
-	0x71, 0x1a, 0x88, 0x8c, 0x9d, 0x00, 0x0d, 0x02, 0xb4, 0x80, 0x25, 0x3b, 0x48, 0x51, 0x38, 0x07,
-	0xca, 0x05, 0x82, 0x5e, 0x04, 0x8a, 0x9c, 0x50, 0x4c, 0x44, 0xae, 0xca, 0x5d, 0x09, 0xd6, 0xb5,
-	0xa7, 0xa2, 0xa7, 0xf6, 0xd0, 0x7b, 0x0e, 0x41, 0x7f, 0x41, 0xfe, 0x40, 0x6e, 0x69, 0x4e, 0x01,
-	0x7a, 0x29, 0x7a, 0x08, 0x8a, 0xe4, 0x52, 0xf4, 0x27, 0x14, 0x28, 0x50, 0x70, 0x77, 0x29, 0x93,
-	0x14, 0xfd, 0x05, 0xf4, 0x64, 0xee, 0xcc, 0xb3, 0xb3, 0xcf, 0xcc, 0x3e, 0x9a, 0x59, 0xc3, 0xff,
-	0x9c, 0xc0, 0x1c, 0xb9, 0x6c, 0xac, 0x8f, 0xb6, 0x74, 0x8f, 0x3a, 0x54, 0x1b, 0x04, 0x84, 0x11,
-	0x15, 0xa4, 0x59, 0x1b, 0x6d, 0x55, 0xaa, 0x16, 0xa1, 0x1e, 0xa1, 0x7a, 0xd7, 0xa4, 0xa8, 0x8f,
-	0xb6, 0xba, 0xc8, 0xcc, 0x2d, 0xdd, 0x22, 0xae, 0x2f, 0xb0, 0x95, 0x75, 0xe1, 0xef, 0xf0, 0x95,
-	0x2e, 0x16, 0xd2, 0x55, 0x8e, 0x45, 0x8f, 0x22, 0x0a, 0xcf, 0x9a, 0x43, 0x1c, 0x22, 0x76, 0x84,
-	0x5f, 0xd2, 0x7a, 0xc5, 0x21, 0xc4, 0xe9, 0xa3, 0x6e, 0x0e, 0x5c, 0xdd, 0xf4, 0x7d, 0xc2, 0x4c,
-	0xe6, 0x12, 0x3f, 0x8a, 0xb6, 0x2e, 0xbd, 0x7c, 0xd5, 0x1d, 0x3e, 0xd5, 0x4d, 0x5f, 0x86, 0xab,
-	0xff, 0xaa, 0xc0, 0xca, 0x3e, 0x75, 0xda, 0xe8, 0xdb, 0x07, 0x64, 0x8f, 0xf5, 0x30, 0xc0, 0xa1,
-	0xa7, 0x5e, 0x84, 0x59, 0x8a, 0xbe, 0x8d, 0x41, 0x59, 0xd9, 0x50, 0x36, 0xe7, 0x0c, 0xb9, 0x52,
-	0x1b, 0xa0, 0xa2, 0xc4, 0x74, 0x02, 0xb4, 0xdc, 0x81, 0x8b, 0x3e, 0x2b, 0xe7, 0x39, 0x66, 0x25,
-	0xf2, 0x18, 0x91, 0x43, 0xfd, 0x14, 0x66, 0x4d, 0x8f, 0x0c, 0x7d, 0x56, 0x2e, 0x6c, 0x28, 0x9b,
-	0xa5, 0xed, 0x75, 0x4d, 0x26, 0x19, 0x56, 0x44, 0x93, 0x15, 0xd1, 0x5a, 0xc4, 0xf5, 0x9b, 0xc5,
-	0x37, 0xef, 0x6b, 0x39, 0x43, 0xc2, 0xd5, 0xcf, 0x01, 0xba, 0x81, 0x6b, 0x3b, 0xd8, 0x79, 0x8a,
-	0x58, 0x2e, 0x9e, 0x6d, 0xf3, 0x9c, 0xd8, 0xf2, 0x10, 0xb1, 0x7e, 0x1b, 0xd6, 0xa7, 0x92, 0x32,
-	0x90, 0x0e, 0x88, 0x4f, 0x51, 0x5d, 0x84, 0xbc, 0x6b, 0xf3, 0xc4, 0x8a, 0x46, 0xde, 0xb5, 0xeb,
-	0x3b, 0x70, 0x69, 0x9f, 0x3a, 0x2d, 0xd3, 0xb7, 0xb0, 0x9f, 0xaa, 0x43, 0x0a, 0x1a, 0xab, 0x4b,
-	0x3e, 0x5e, 0x97, 0xfa, 0x35, 0xa8, 0x1d, 0x13, 0x22, 0x3a, 0xb5, 0xbe, 0xc3, 0xeb, 0x6c, 0xe0,
-	0x37, 0x43, 0xa4, 0xac, 0x69, 0x32, 0xab, 0x77, 0x70, 0xa8, 0xae, 0xc1, 0x8c, 0x8d, 0x3e, 0xf1,
-	0x64, 0x99, 0xc5, 0x82, 0x9f, 0xe2, 0x3a, 0x7e, 0xec, 0x14, 0xbe, 0xaa, 0x5f, 0xe6, 0x59, 0x25,
-	0x43, 0x4c, 0xe2, 0xff, 0xa4, 0x70, 0x0e, 0xed, 0x61, 0xd7, 0x73, 0x59, 0x74, 0xfa, 0xc1, 0x61,
-	0x8b, 0xf8, 0x4f, 0xdd, 0xc0, 0xe3, 0x72, 0x50, 0x0f, 0x60, 0xde, 0x8a, 0xad, 0xf9, 0xa9, 0xa5,
-	0xed, 0x35, 0x4d, 0xc8, 0x43, 0x8b, 0xe4, 0xa1, 0xed, 0xf8, 0xe3, 0x66, 0xe5, 0xed, 0xab, 0xc6,
-	0xc5, 0xec, 0x38, 0x46, 0x22, 0xca, 0x71, 0x74, 0x1f, 0x14, 0xbf, 0x7b, 0x51, 0xcb, 0xd5, 0x5f,
-	0x2b, 0x50, 0x69, 0x11, 0x9f, 0x05, 0xa6, 0xc5, 0x5a, 0x66, 0xbf, 0x9f, 0xa2, 0xd4, 0x00, 0xd5,
-	0xf5, 0x47, 0x66, 0xdf, 0xb5, 0xf9, 0xba, 0x43, 0x2d, 0x32, 0x40, 0x4e, 0x6c, 0xde, 0x58, 0x89,
-	0x7b, 0xda, 0xa1, 0x63, 0x0a, 0xee, 0x13, 0xdf, 0x42, 0x7e, 0x6e, 0x31, 0x09, 0x7f, 0x1c, 0x3a,
-	0xd4, 0x9b, 0xb0, 0x34, 0xd1, 0xab, 0xe4, 0x58, 0xe0, 0x1c, 0x17, 0x23, 0x73, 0x9b, 0x5b, 0xd5,
-	0x2b, 0x30, 0x17, 0xfa, 0x4d, 0x36, 0x0c, 0x84, 0xde, 0xe6, 0x8d, 0x23, 0x43, 0xfd, 0xa5, 0x02,
-	0xab, 0xb2, 0xde, 0x09, 0xf2, 0x37, 0x60, 0x91, 0x91, 0xe7, 0xe8, 0x77, 0x2c, 0x99, 0xa0, 0xbc,
-	0xc7, 0x05, 0x6e, 0x8d, 0xb2, 0x56, 0x6b, 0x50, 0xea, 0x86, 0xbb, 0x13, 0x6c, 0x81, 0x9b, 0xfe,
-	0x53, 0x9a, 0xdf, 0x2b, 0x70, 0x49, 0x00, 0xdb, 0xc8, 0x52, 0x54, 0x37, 0x61, 0x59, 0x44, 0xee,
-	0x50, 0x64, 0x92, 0x88, 0xd0, 0xf5, 0x22, 0x8d, 0xb6, 0x1c, 0x4b, 0x26, 0x7f, 0x3a, 0x99, 0x42,
-	0x9a, 0xcc, 0x2d, 0xb8, 0x79, 0x8a, 0x1c, 0x27, 0xd2, 0x1d, 0xc2, 0xc5, 0x29, 0xe8, 0xde, 0x28,
-	0x6c, 0x20, 0x9f, 0xc1, 0x0c, 0x86, 0x1f, 0x27, 0x2a, 0x75, 0xe5, 0xed, 0xab, 0xc6, 0x42, 0x62,
-	0x9f, 0x21, 0x76, 0x9d, 0xa2, 0xcc, 0x0d, 0xa8, 0x66, 0x1f, 0x3b, 0x21, 0xf6, 0x5a, 0x81, 0xa5,
-	0x7d, 0xea, 0xec, 0x62, 0x1f, 0x1d, 0x93, 0xe1, 0x97, 0x38, 0xa6, 0xea, 0x6d, 0x58, 0x91, 0x2a,
-	0x23, 0x41, 0xc7, 0xb4, 0xed, 0x00, 0x29, 0x95, 0xd7, 0xbe, 0x3c, 0x71, 0xec, 0x08, 0xbb, 0xba,
-	0x05, 0x6b, 0x24, 0xb0, 0x7a, 0x48, 0x59, 0x90, 0xc0, 0x0b, 0x3a, 0xab, 0x71, 0x5f, 0xb4, 0xe5,
-	0x16, 0x2c, 0x4f, 0xca, 0x1f, 0xc1, 0x85, 0x18, 0x26, 0xd7, 0x12, 0x41, 0xaf, 0xc3, 0x02, 0xb2,
-	0x5e, 0x27, 0xad, 0x88, 0x79, 0x64, 0xbd, 0xf6, 0xe4, 0x1e, 0xd6, 0x79, 0x77, 0x8b, 0xa7, 0x30,
-	0x49, 0xef, 0x09, 0xac, 0xc6, 0xed, 0xe1, 0x9e, 0x7d, 0xea, 0x9c, 0x2f, 0xc3, 0x35, 0x98, 0x89,
-	0xab, 0x5a, 0x2c, 0xea, 0x2f, 0xf3, 0xb0, 0x22, 0xfa, 0x60, 0x8b, 0xf7, 0x6c, 0x71, 0x9b, 0x35,
-	0x28, 0xf1, 0x7b, 0x49, 0xc8, 0x0f, 0xb8, 0x49, 0x48, 0x6f, 0xfa, 0xf7, 0x94, 0xcf, 0xfa, 0x3d,
-	0x3d, 0x4c, 0x8c, 0x95, 0xb9, 0xa6, 0x16, 0xb6, 0xff, 0xdf, 0xdf, 0xd7, 0xfe, 0xef, 0xb8, 0xac,
-	0x37, 0xec, 0x6a, 0x16, 0xf1, 0xe4, 0x34, 0x95, 0x7f, 0x1a, 0xd4, 0x7e, 0xae, 0xb3, 0xf1, 0x00,
-	0xa9, 0xf6, 0x85, 0xcf, 0x26, 0x53, 0x26, 0xa1, 0x74, 0xd1, 0xd6, 0x8b, 0x29, 0xa5, 0x8b, 0xb1,
-	0x77, 0x13, 0x96, 0xe4, 0xa8, 0x0e, 0xd0, 0x42, 0x77, 0x84, 0x41, 0x79, 0x46, 0x00, 0x85, 0xd9,
-	0x90, 0xd6, 0x44, 0xc4, 0x1e, 0xba, 0x4e, 0x8f, 0x95, 0x67, 0xc5, 0x8f, 0x2c, 0x32, 0x3f, 0xe2,
-	0xd6, 0x07, 0xc5, 0x3f, 0x5f, 0xd4, 0x94, 0xfa, 0xcf, 0x0a, 0xa8, 0xbc, 0xaf, 0xec, 0x1d, 0xa2,
-	0x35, 0x64, 0x68, 0x8b, 0x3a, 0x9d, 0xbd, 0xad, 0xc4, 0xcb, 0x99, 0x9f, 0x2a, 0x67, 0x06, 0x9b,
-	0x42, 0x16, 0x9b, 0x74, 0x83, 0x2a, 0xa6, 0x1b, 0x54, 0xfd, 0x1f, 0x05, 0xd6, 0xe3, 0x4d, 0x3c,
-	0xc9, 0xf7, 0xd4, 0x7b, 0x75, 0x32, 0x9b, 0x7c, 0x48, 0x78, 0xbe, 0x79, 0xff, 0xef, 0xf7, 0xb5,
-	0x7b, 0xb1, 0x8b, 0x63, 0xbc, 0xe4, 0x9e, 0xeb, 0xb3, 0xf8, 0x67, 0xdf, 0xed, 0x52, 0xbd, 0x3b,
-	0x66, 0x48, 0xb5, 0x47, 0x78, 0xd8, 0x0c, 0x3f, 0xce, 0x3e, 0x1e, 0x0a, 0x67, 0x19, 0x0f, 0xb2,
-	0x40, 0xc5, 0xac, 0x02, 0xd5, 0x7f, 0xcc, 0x83, 0xba, 0x67, 0xb4, 0xb6, 0xef, 0xec, 0xe2, 0xa0,
-	0x4f, 0xc6, 0x67, 0x4e, 0xfc, 0x5a, 0x38, 0x70, 0xb9, 0x70, 0xc4, 0x98, 0x17, 0x72, 0x2e, 0x09,
-	0xdb, 0x2e, 0x1f, 0xf6, 0xd3, 0x97, 0x5d, 0xc8, 0xba, 0xec, 0xab, 0x00, 0x18, 0x58, 0xdb, 0x77,
-	0x3a, 0xbe, 0xe9, 0xa1, 0x94, 0xe9, 0x1c, 0xb7, 0x3c, 0x36, 0x3d, 0x7e, 0x90, 0x70, 0xd3, 0xb1,
-	0xd7, 0x25, 0x7d, 0x29, 0xcf, 0x12, 0xb7, 0xb5, 0xb9, 0x29, 0x3c, 0x48, 0x40, 0x6c, 0xb4, 0x5c,
-	0xcf, 0xec, 0x53, 0x29, 0xcd, 0x05, 0x6e, 0xdd, 0x95, 0xc6, 0xac, 0x9a, 0x5c, 0xc8, 0xac, 0xc9,
-	0x2f, 0x0a, 0x94, 0x63, 0xd3, 0xe6, 0x9c, 0x92, 0x68, 0xc0, 0x6a, 0x6c, 0x1e, 0xb1, 0xc3, 0x84,
-	0x88, 0x97, 0xe9, 0x51, 0xdc, 0x73, 0x4a, 0xf9, 0x1e, 0x5c, 0xf0, 0xd0, 0xeb, 0x62, 0x40, 0xcb,
-	0xc5, 0x8d, 0xc2, 0x66, 0x69, 0xbb, 0xa2, 0x1d, 0xbd, 0xc8, 0xb5, 0xbd, 0xc4, 0x04, 0x33, 0x22,
-	0xe8, 0xf6, 0x5f, 0x45, 0x28, 0x84, 0xad, 0xef, 0x09, 0x2c, 0xa6, 0x5e, 0x80, 0x57, 0xe3, 0xdb,
-	0xa7, 0xde, 0x94, 0x95, 0x1b, 0x27, 0xba, 0x27, 0x9d, 0x36, 0xa7, 0x3e, 0x83, 0xb5, 0xcc, 0x17,
-	0xe6, 0xf5, 0x54, 0x80, 0x2c, 0x50, 0xe5, 0xf6, 0x19, 0x40, 0xb1, 0xb3, 0x9e, 0xc0, 0x62, 0xea,
-	0x9d, 0x99, 0xce, 0x22, 0xe9, 0x9e, 0xca, 0xe2, 0x98, 0x27, 0x66, 0x4e, 0xfd, 0x56, 0x81, 0x2b,
-	0x27, 0xbe, 0x30, 0xd3, 0x4c, 0x4f, 0x02, 0x57, 0xee, 0x9e, 0x03, 0x1c, 0x23, 0xe1, 0xc0, 0x6a,
-	0xd6, 0x5b, 0xa1, 0x7e, 0x62, 0x34, 0x8e, 0xa9, 0x7c, 0x72, 0x3a, 0x26, 0x76, 0xd0, 0x57, 0xb0,
-	0xd4, 0x46, 0x96, 0x98, 0xfe, 0x97, 0x53, 0x01, 0xe2, 0xce, 0xca, 0xf5, 0x13, 0x9c, 0x47, 0x61,
-	0x9b, 0xc6, 0x9b, 0x0f, 0x55, 0xe5, 0xdd, 0x87, 0xaa, 0xf2, 0xc7, 0x87, 0xaa, 0xf2, 0xc3, 0xc7,
-	0x6a, 0xee, 0xdd, 0xc7, 0x6a, 0xee, 0xb7, 0x8f, 0xd5, 0xdc, 0xd7, 0xf7, 0x63, 0x7d, 0x70, 0x80,
-	0x8e, 0x33, 0x7e, 0x36, 0x8a, 0xfe, 0xfb, 0x6b, 0x88, 0x7f, 0x6e, 0x74, 0x8f, 0xd8, 0xc3, 0x3e,
-	0xea, 0x87, 0x91, 0x5d, 0x8c, 0xb5, 0xee, 0x2c, 0x7f, 0x11, 0xdd, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xc2, 0xd3, 0xe2, 0x96, 0x0e, 0x00, 0x00,
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
-
-func (this *SendToCosmosEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SendToCosmosEvent)
-	if !ok {
-		that2, ok := that.(SendToCosmosEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.EventNonce != that1.EventNonce {
-		return false
-	}
-	if this.TokenContract != that1.TokenContract {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.EthereumSender != that1.EthereumSender {
-		return false
-	}
-	if this.CosmosReceiver != that1.CosmosReceiver {
-		return false
-	}
-	if this.EthereumHeight != that1.EthereumHeight {
-		return false
-	}
-	return true
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error)
-	CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error)
-	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
-	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
-	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
-	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *DelegateKeysSignMsg) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
+// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
+// address.
+type SendToCosmosEvent struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	EthereumSender string                                 `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EthereumHeight uint64                                 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// received_amount is the balance delta of the bridge contract for this
+	// deposit, only used for balance delta accounting tokens
+	ReceivedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"received_amount"`
+	// escrow_balance is the bridge contract token balance after the deposit,
+	// only used for balance delta accounting tokens
+	EscrowBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow_balance"`
 }
 
-func (c *msgClient) SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error) {
-	out := new(MsgSendToEthereumResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereum", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
+func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
+func (*SendToCosmosEvent) ProtoMessage()    {}
+func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
-
-func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error) {
-	out := new(MsgCancelSendToEthereumResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEthereum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToCosmosEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SendToCosmosEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToCosmosEvent.Merge(m, src)
+}
+func (m *SendToCosmosEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToCosmosEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToCosmosEvent.DiscardUnknown(m)
 }
 
-func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
-	out := new(MsgRequestBatchTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SendToCosmosEvent proto.InternalMessageInfo
+
+func (m *SendToCosmosEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error) {
-	out := new(MsgSubmitEthereumTxConfirmationResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error) {
-	out := new(MsgSubmitEthereumEventResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
-	out := new(MsgDelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetDelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
-	CancelSendToEthereum(context.Context, *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error)
-	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
-	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
-	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
-	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+func (m *SendToCosmosEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
+// bridge contract was executed successfully on ETH
+type BatchExecutedEvent struct {
+	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	BatchNonce     uint64 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	// escrow_balance is the bridge contract token balance after the batch,
+	// only used for balance delta accounting tokens
+	EscrowBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow_balance"`
 }
 
-func (*UnimplementedMsgServer) SendToEthereum(ctx context.Context, req *MsgSendToEthereum) (*MsgSendToEthereumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereum not implemented")
+func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
+func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*BatchExecutedEvent) ProtoMessage()    {}
+func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
-func (*UnimplementedMsgServer) CancelSendToEthereum(ctx context.Context, req *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEthereum not implemented")
+func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitEthereumTxConfirmation(ctx context.Context, req *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmation not implemented")
+func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvent not implemented")
+func (m *BatchExecutedEvent) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
 
-func _Msg_SendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendToEthereum)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendToEthereum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SendToEthereum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendToEthereum(ctx, req.(*MsgSendToEthereum))
+func (m *BatchExecutedEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSendToEthereum)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSendToEthereum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/CancelSendToEthereum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSendToEthereum(ctx, req.(*MsgCancelSendToEthereum))
+func (m *BatchExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBatchTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBatchTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/RequestBatchTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+func (m *BatchExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitEthereumTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitEthereumTxConfirmation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, req.(*MsgSubmitEthereumTxConfirmation))
+func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitEthereumEvent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEthereumEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEthereumEvent(ctx, req.(*MsgSubmitEthereumEvent))
-	}
-	return interceptor(ctx, in, info, handler)
+// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
+// investigate?
+type ContractCallExecutedEvent struct {
+	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	EthereumHeight    uint64                                               `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
-func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateKeys)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDelegateKeys(ctx, in)
+func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
+func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractCallExecutedEvent) ProtoMessage()    {}
+func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SetDelegateKeys",
+}
+func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
+}
+func (m *ContractCallExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+
+func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+	return 0
+}
+
+func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendToEthereum",
-			Handler:    _Msg_SendToEthereum_Handler,
-		},
-		{
-			MethodName: "CancelSendToEthereum",
-			Handler:    _Msg_CancelSendToEthereum_Handler,
-		},
-		{
-			MethodName: "RequestBatchTx",
-			Handler:    _Msg_RequestBatchTx_Handler,
-		},
-		{
-			MethodName: "SubmitEthereumTxConfirmation",
-			Handler:    _Msg_SubmitEthereumTxConfirmation_Handler,
-		},
-		{
-			MethodName: "SubmitEthereumEvent",
-			Handler:    _Msg_SubmitEthereumEvent_Handler,
-		},
-		{
-			MethodName: "SetDelegateKeys",
-			Handler:    _Msg_SetDelegateKeys_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gravity/v1/msgs.proto",
+func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
 }
 
-func (m *MsgSendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ERC20DeployedEvent is submitted when an ERC20 contract
+// for a Cosmos SDK coin has been deployed on Ethereum.
+type ERC20DeployedEvent struct {
+	EventNonce     uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	CosmosDenom    string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Erc20Name      string `protobuf:"bytes,4,opt,name=erc20_name,json=erc20Name,proto3" json:"erc20_name,omitempty"`
+	Erc20Symbol    string `protobuf:"bytes,5,opt,name=erc20_symbol,json=erc20Symbol,proto3" json:"erc20_symbol,omitempty"`
+	Erc20Decimals  uint64 `protobuf:"varint,6,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,7,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
-func (m *MsgSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
+func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
+func (*ERC20DeployedEvent) ProtoMessage()    {}
+func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
+}
+func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20DeployedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ERC20DeployedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20DeployedEvent.Merge(m, src)
+}
+func (m *ERC20DeployedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20DeployedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20DeployedEvent.DiscardUnknown(m)
 }
 
-func (m *MsgSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ERC20DeployedEvent proto.InternalMessageInfo
+
+func (m *ERC20DeployedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20DeployedEvent) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
 }
 
-func (m *MsgSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *ERC20DeployedEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20DeployedEvent) GetErc20Name() string {
+	if m != nil {
+		return m.Erc20Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20DeployedEvent) GetErc20Symbol() string {
+	if m != nil {
+		return m.Erc20Symbol
+	}
+	return ""
 }
 
-func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *ERC20DeployedEvent) GetErc20Decimals() uint64 {
+	if m != nil {
+		return m.Erc20Decimals
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20DeployedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// This informs the Cosmos module that a validator
+// set has been updated.
+type SignerSetTxExecutedEvent struct {
+	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
+	EthereumHeight   uint64            `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	Members          []*EthereumSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
+func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
+func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
 }
-
-func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
 }
-
-func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
 }
 
-func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+
+func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.SignerSetTxNonce
+	}
+	return 0
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SignerSetTxExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetTxExecutedEvent) GetMembers() []*EthereumSigner {
+	if m != nil {
+		return m.Members
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgSendToEthereum)(nil), "gravity.v1.MsgSendToEthereum")
+	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
+	proto.RegisterType((*MsgSendToEthereumMulti)(nil), "gravity.v1.MsgSendToEthereumMulti")
+	proto.RegisterType((*SendToEthereumEntry)(nil), "gravity.v1.SendToEthereumEntry")
+	proto.RegisterType((*MsgSendToEthereumMultiResponse)(nil), "gravity.v1.MsgSendToEthereumMultiResponse")
+	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
+	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
+	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
+	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
+	proto.RegisterType((*ContractCallTxConfirmation)(nil), "gravity.v1.ContractCallTxConfirmation")
+	proto.RegisterType((*BatchTxConfirmation)(nil), "gravity.v1.BatchTxConfirmation")
+	proto.RegisterType((*SignerSetTxConfirmation)(nil), "gravity.v1.SignerSetTxConfirmation")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmationResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationResponse")
+	proto.RegisterType((*MsgSubmitEthereumEvent)(nil), "gravity.v1.MsgSubmitEthereumEvent")
+	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmations)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmations")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmationsResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationsResponse")
+	proto.RegisterType((*EthereumTxConfirmationResult)(nil), "gravity.v1.EthereumTxConfirmationResult")
+	proto.RegisterType((*MsgSubmitEthereumEvents)(nil), "gravity.v1.MsgSubmitEthereumEvents")
+	proto.RegisterType((*MsgSubmitEthereumEventsResponse)(nil), "gravity.v1.MsgSubmitEthereumEventsResponse")
+	proto.RegisterType((*EthereumEventVoteResult)(nil), "gravity.v1.EthereumEventVoteResult")
+	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
+	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
+	proto.RegisterType((*MsgResolveQuarantinedDeposit)(nil), "gravity.v1.MsgResolveQuarantinedDeposit")
+	proto.RegisterType((*MsgResolveQuarantinedDepositResponse)(nil), "gravity.v1.MsgResolveQuarantinedDepositResponse")
+	proto.RegisterType((*DepositResolutionSignMsg)(nil), "gravity.v1.DepositResolutionSignMsg")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
+	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
+	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
+	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
+	proto.RegisterType((*ERC20DeployedEvent)(nil), "gravity.v1.ERC20DeployedEvent")
+	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "gravity.v1.SignerSetTxExecutedEvent")
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
+
+var fileDescriptor_2f8523f2f6feb451 = []byte{
+	// 1756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0xd9, 0x5e, 0x3f, 0x7f, 0xd3, 0xce, 0x5a, 0x66, 0xbc, 0xb2, 0x43, 0x77, 0xb3,
+	0x4e, 0xb6, 0x96, 0xd6, 0xce, 0xa2, 0x0d, 0x02, 0xb4, 0x81, 0xbf, 0x82, 0x5d, 0x14, 0x0e, 0x50,
+	0x6a, 0x93, 0x2e, 0x7a, 0x11, 0x28, 0xf2, 0x99, 0x62, 0x42, 0x72, 0x14, 0xce, 0x48, 0x95, 0xae,
+	0x05, 0x0a, 0x14, 0xed, 0xa5, 0x3d, 0x14, 0xe8, 0x31, 0x87, 0xde, 0x7b, 0xc9, 0xad, 0xe8, 0xa1,
+	0x3d, 0xa5, 0x39, 0x2d, 0xd0, 0x1e, 0x8a, 0x1e, 0x16, 0xc5, 0xee, 0xa5, 0x7f, 0x43, 0x81, 0x02,
+	0x05, 0x67, 0x86, 0x32, 0x49, 0x51, 0x1f, 0xde, 0xec, 0x49, 0x9a, 0xf7, 0xde, 0xbc, 0x79, 0xef,
+	0x37, 0xef, 0x6b, 0x08, 0x6f, 0x38, 0xa1, 0xd9, 0x75, 0x59, 0xbf, 0xd6, 0x3d, 0xaa, 0xf9, 0xd4,
+	0xa1, 0xd5, 0x76, 0x48, 0x18, 0x51, 0x41, 0x92, 0xab, 0xdd, 0x23, 0xad, 0x62, 0x11, 0xea, 0x13,
+	0x5a, 0x6b, 0x9a, 0x14, 0x6b, 0xdd, 0xa3, 0x26, 0x32, 0xf3, 0xa8, 0x66, 0x11, 0x37, 0x10, 0xb2,
+	0xda, 0xb6, 0xe0, 0x37, 0xf8, 0xaa, 0x26, 0x16, 0x92, 0x55, 0x4e, 0x68, 0x8f, 0x35, 0x0a, 0xce,
+	0xa6, 0x43, 0x1c, 0x22, 0x76, 0x44, 0xff, 0x24, 0x75, 0xc7, 0x21, 0xc4, 0xf1, 0xb0, 0x66, 0xb6,
+	0xdd, 0x9a, 0x19, 0x04, 0x84, 0x99, 0xcc, 0x25, 0x41, 0xac, 0x6d, 0x5b, 0x72, 0xf9, 0xaa, 0xd9,
+	0xb9, 0xaa, 0x99, 0x81, 0x54, 0xa7, 0xff, 0x5d, 0x81, 0xf5, 0x4b, 0xea, 0xd4, 0x31, 0xb0, 0x9f,
+	0x90, 0x0b, 0xd6, 0xc2, 0x10, 0x3b, 0xbe, 0x7a, 0x1b, 0xe6, 0x28, 0x06, 0x36, 0x86, 0x65, 0x65,
+	0x4f, 0x39, 0x58, 0x30, 0xe4, 0x4a, 0x3d, 0x04, 0x15, 0xa5, 0x4c, 0x23, 0x44, 0xcb, 0x6d, 0xbb,
+	0x18, 0xb0, 0x72, 0x81, 0xcb, 0xac, 0xc7, 0x1c, 0x23, 0x66, 0xa8, 0xdf, 0x87, 0x39, 0xd3, 0x27,
+	0x9d, 0x80, 0x95, 0x8b, 0x7b, 0xca, 0xc1, 0xe2, 0xf1, 0x76, 0x55, 0x3a, 0x19, 0x21, 0x52, 0x95,
+	0x88, 0x54, 0xcf, 0x88, 0x1b, 0x9c, 0x96, 0xbe, 0x7e, 0xbe, 0x3b, 0x63, 0x48, 0x71, 0xf5, 0x87,
+	0x00, 0xcd, 0xd0, 0xb5, 0x1d, 0x6c, 0x5c, 0x21, 0x96, 0x4b, 0xd3, 0x6d, 0x5e, 0x10, 0x5b, 0x3e,
+	0x42, 0xd4, 0xef, 0xc3, 0xf6, 0x90, 0x53, 0x06, 0xd2, 0x36, 0x09, 0x28, 0xaa, 0x2b, 0x50, 0x70,
+	0x6d, 0xee, 0x58, 0xc9, 0x28, 0xb8, 0xb6, 0xfe, 0x05, 0xdc, 0x1e, 0x12, 0xbe, 0xec, 0x78, 0xcc,
+	0x1d, 0x09, 0xc3, 0x87, 0x30, 0x8f, 0x01, 0x0b, 0x5d, 0xa4, 0xe5, 0xc2, 0x5e, 0xf1, 0x60, 0xf1,
+	0x78, 0xb7, 0x7a, 0x7d, 0xed, 0xd5, 0xb4, 0xa6, 0x8b, 0x80, 0x85, 0x7d, 0x69, 0x61, 0xbc, 0x4b,
+	0xff, 0xb3, 0x02, 0x1b, 0x39, 0x62, 0x23, 0xf0, 0x55, 0x26, 0xe3, 0x5b, 0xf8, 0x36, 0xf8, 0x16,
+	0x6f, 0x8c, 0xef, 0x31, 0x54, 0xf2, 0x21, 0x1b, 0x80, 0xbc, 0x06, 0x45, 0xd7, 0xa6, 0x65, 0x65,
+	0xaf, 0x78, 0x50, 0x32, 0xa2, 0xbf, 0xfa, 0x09, 0x6c, 0x5d, 0x52, 0xe7, 0xcc, 0x0c, 0x2c, 0xf4,
+	0x32, 0xe1, 0x96, 0xb9, 0x91, 0x04, 0xee, 0x85, 0x24, 0xee, 0xfa, 0x5b, 0xb0, 0x3b, 0x42, 0x45,
+	0x7c, 0xae, 0x7e, 0xc2, 0xc3, 0xd9, 0xc0, 0x2f, 0x3a, 0x48, 0xd9, 0xa9, 0xc9, 0xac, 0xd6, 0x93,
+	0x9e, 0xba, 0x09, 0xb3, 0x36, 0x06, 0xc4, 0x97, 0x48, 0x8a, 0x05, 0x3f, 0xc5, 0x75, 0x82, 0xc4,
+	0x29, 0x7c, 0xa5, 0xbf, 0x09, 0xdb, 0x43, 0x2a, 0x06, 0xfa, 0x7f, 0xa7, 0x70, 0x1b, 0xea, 0x9d,
+	0xa6, 0xef, 0xb2, 0xf8, 0xf4, 0x27, 0xbd, 0x33, 0x12, 0x5c, 0xb9, 0xa1, 0xcf, 0xb3, 0x4e, 0x7d,
+	0x02, 0x4b, 0x56, 0x62, 0xcd, 0x4f, 0x5d, 0x3c, 0xde, 0xac, 0x8a, 0x2c, 0xac, 0xc6, 0x59, 0x58,
+	0x3d, 0x09, 0xfa, 0xa7, 0xda, 0x37, 0x5f, 0x1d, 0xde, 0xce, 0xd7, 0x63, 0xa4, 0xb4, 0x8c, 0x32,
+	0xf7, 0x83, 0xd2, 0x2f, 0xbf, 0xdc, 0x9d, 0xd1, 0xff, 0xa2, 0x80, 0x76, 0x46, 0x02, 0x16, 0x9a,
+	0x16, 0x3b, 0x33, 0x3d, 0x2f, 0x63, 0xd2, 0x21, 0xa8, 0x6e, 0xd0, 0x35, 0x3d, 0xd7, 0xe6, 0xeb,
+	0x06, 0xb5, 0x48, 0x1b, 0xb9, 0x61, 0x4b, 0xc6, 0x7a, 0x92, 0x53, 0x8f, 0x18, 0x43, 0xe2, 0x01,
+	0x09, 0x2c, 0xe4, 0xe7, 0x96, 0xd2, 0xe2, 0x1f, 0x47, 0x0c, 0xf5, 0x1e, 0xac, 0x0e, 0xc2, 0x56,
+	0xda, 0x58, 0xe4, 0x36, 0xae, 0xc4, 0xe4, 0x3a, 0xa7, 0xaa, 0x3b, 0xb0, 0x10, 0xf1, 0x4d, 0xd6,
+	0x09, 0x45, 0x5a, 0x2f, 0x19, 0xd7, 0x04, 0xfd, 0x0f, 0x0a, 0x6c, 0x48, 0xbc, 0x53, 0xc6, 0xdf,
+	0x85, 0x15, 0x46, 0x3e, 0xc7, 0xa0, 0x61, 0x49, 0x07, 0xe5, 0x3d, 0x2e, 0x73, 0x6a, 0xec, 0xb5,
+	0xba, 0x0b, 0x8b, 0xcd, 0x68, 0x77, 0xca, 0x5a, 0xe0, 0xa4, 0xd7, 0x6a, 0xe6, 0xaf, 0x14, 0xd8,
+	0x12, 0x82, 0x75, 0x64, 0x19, 0x53, 0x0f, 0x60, 0x4d, 0x68, 0x6e, 0x50, 0x64, 0xd2, 0x10, 0x11,
+	0xd7, 0x2b, 0x34, 0xde, 0x32, 0xd2, 0x98, 0xc2, 0x64, 0x63, 0x8a, 0x59, 0x63, 0xde, 0x81, 0x7b,
+	0x13, 0xc2, 0x71, 0x10, 0xba, 0x1d, 0xb8, 0x3d, 0x24, 0x7a, 0xd1, 0x8d, 0xea, 0xc8, 0x0f, 0x60,
+	0x16, 0xbb, 0x71, 0xa5, 0x19, 0x15, 0xa9, 0xeb, 0xdf, 0x7c, 0x75, 0xb8, 0x9c, 0xda, 0x67, 0x88,
+	0x5d, 0x13, 0x22, 0x73, 0x0f, 0x2a, 0xf9, 0xc7, 0x0e, 0x0c, 0xfb, 0xbd, 0x02, 0x7b, 0x13, 0x9c,
+	0xa0, 0xea, 0xa7, 0xb0, 0x9c, 0x4c, 0x07, 0x51, 0x5a, 0x5e, 0x25, 0xab, 0xd2, 0x6a, 0x26, 0x18,
+	0xcf, 0xe0, 0x60, 0x92, 0x65, 0x83, 0x92, 0xf7, 0x08, 0xe6, 0x43, 0xa4, 0x1d, 0x8f, 0xc5, 0xb6,
+	0x1d, 0x24, 0xbb, 0xc2, 0xc8, 0xcb, 0xe9, 0x78, 0x2c, 0x6e, 0x0f, 0x72, 0xbb, 0xde, 0x82, 0x9d,
+	0x71, 0xe2, 0x51, 0xa4, 0x53, 0x46, 0x42, 0x6c, 0xb8, 0x81, 0x8d, 0x3d, 0x99, 0xc6, 0xc0, 0x49,
+	0x8f, 0x23, 0xca, 0xd4, 0xc1, 0xa5, 0xf7, 0x60, 0x6b, 0xc8, 0x3f, 0x7e, 0x39, 0x54, 0xfd, 0x10,
+	0xe6, 0xf8, 0xf5, 0x8e, 0x47, 0x3a, 0x27, 0x2a, 0xe4, 0xb6, 0x09, 0xc8, 0x5e, 0xe5, 0xd4, 0x51,
+	0x71, 0xf2, 0x00, 0xd0, 0xb3, 0x2c, 0xa0, 0xfb, 0x79, 0x80, 0xf2, 0x4d, 0x9f, 0x12, 0x86, 0xf9,
+	0x58, 0xfe, 0x5a, 0x81, 0xad, 0x11, 0xa2, 0x11, 0x8e, 0xdc, 0xd6, 0x54, 0xa2, 0x02, 0x27, 0x89,
+	0x24, 0xbd, 0x03, 0x62, 0xd5, 0x68, 0x99, 0xb4, 0xc5, 0xdd, 0x58, 0x32, 0x16, 0x38, 0xe5, 0x91,
+	0x49, 0x5b, 0x51, 0x5f, 0xe9, 0x12, 0x86, 0x94, 0xa7, 0x65, 0xc9, 0x10, 0x0b, 0x55, 0x83, 0x5b,
+	0xa6, 0x65, 0x61, 0x9b, 0xa1, 0xcd, 0x8b, 0xc7, 0x2d, 0x63, 0xb0, 0x8e, 0xca, 0xf4, 0xea, 0x25,
+	0x75, 0xce, 0xd1, 0x43, 0xc7, 0x64, 0xf8, 0x23, 0xec, 0x53, 0xf5, 0x3e, 0xac, 0xcb, 0x82, 0x4a,
+	0xc2, 0x86, 0x69, 0xdb, 0x21, 0x52, 0x2a, 0x2b, 0xdc, 0xda, 0x80, 0x71, 0x22, 0xe8, 0xea, 0x11,
+	0x6c, 0x92, 0xd0, 0x6a, 0x21, 0x65, 0x61, 0x4a, 0x5e, 0x40, 0xbc, 0x91, 0xe4, 0xc5, 0x5b, 0xde,
+	0x81, 0xb5, 0x41, 0x30, 0xc4, 0xe2, 0xa2, 0xee, 0x0d, 0x82, 0x24, 0x16, 0xdd, 0x87, 0x65, 0x64,
+	0xad, 0x46, 0xb6, 0xf8, 0x2d, 0x21, 0x6b, 0xd5, 0x07, 0x25, 0x67, 0x1b, 0xb6, 0x32, 0x2e, 0x0c,
+	0x32, 0xf9, 0x8f, 0x0a, 0xec, 0xf0, 0xde, 0x49, 0x89, 0xd7, 0xc5, 0x1f, 0x77, 0xcc, 0xd0, 0x0c,
+	0x98, 0x1b, 0xa0, 0x7d, 0x8e, 0x6d, 0x42, 0xdd, 0x29, 0x10, 0xbf, 0x07, 0xab, 0x72, 0x2a, 0x0e,
+	0xd1, 0x42, 0xb7, 0x7b, 0x1d, 0xb9, 0x82, 0x6c, 0x48, 0x6a, 0x6a, 0x54, 0xca, 0xd6, 0xc7, 0xf5,
+	0x64, 0x94, 0x73, 0x46, 0x22, 0x18, 0x4b, 0xa9, 0x66, 0xff, 0x36, 0x7c, 0x67, 0x9c, 0xc1, 0x03,
+	0xcf, 0xfe, 0xa4, 0x40, 0xf9, 0x9a, 0x46, 0xbc, 0x0e, 0x6f, 0x96, 0xae, 0x13, 0x5c, 0x52, 0xe7,
+	0x35, 0x7a, 0x75, 0x07, 0xe2, 0x07, 0x44, 0xc3, 0xb5, 0xe5, 0x2d, 0x2d, 0x48, 0xca, 0x63, 0x5b,
+	0xfd, 0x1e, 0x6c, 0xc9, 0xb9, 0x6d, 0xe8, 0x46, 0x85, 0x5b, 0x6f, 0x08, 0xf6, 0x45, 0xfa, 0x5e,
+	0xf5, 0xa7, 0xb0, 0x91, 0xbc, 0xaf, 0xd8, 0xee, 0x1b, 0x45, 0xde, 0x26, 0xcc, 0x26, 0x1b, 0xab,
+	0x58, 0xe8, 0xff, 0x28, 0xc2, 0xba, 0x18, 0xc5, 0xce, 0xb8, 0x27, 0xa2, 0xa1, 0x4c, 0x04, 0x64,
+	0xb8, 0xa5, 0x17, 0xf2, 0x5a, 0xfa, 0x47, 0xa9, 0x07, 0xc4, 0xc2, 0x69, 0x35, 0xca, 0xed, 0x7f,
+	0x3d, 0xdf, 0x7d, 0xdb, 0x71, 0x59, 0xab, 0xd3, 0xac, 0x5a, 0xc4, 0x97, 0xef, 0x26, 0xf9, 0x73,
+	0x48, 0xed, 0xcf, 0x6b, 0xac, 0xdf, 0x46, 0x5a, 0x7d, 0x1c, 0x95, 0x22, 0xb1, 0x3b, 0x5d, 0x0f,
+	0xc5, 0x64, 0x59, 0xca, 0xd4, 0x43, 0x4e, 0xcd, 0xbb, 0xa8, 0xd9, 0xdc, 0x8b, 0x4a, 0x6a, 0x6c,
+	0xa1, 0xeb, 0xb4, 0x58, 0x79, 0x4e, 0xf4, 0xf9, 0x98, 0xfc, 0x88, 0x53, 0xd5, 0x9f, 0xc0, 0xaa,
+	0x54, 0x65, 0x37, 0xa4, 0x2f, 0xf3, 0xaf, 0xe4, 0xcb, 0x4a, 0xac, 0xe6, 0x44, 0xf8, 0xf4, 0x09,
+	0xac, 0x20, 0xb5, 0x42, 0xf2, 0xb3, 0x46, 0xd3, 0xf4, 0xa2, 0x91, 0xb8, 0x7c, 0xeb, 0x95, 0xf4,
+	0x2e, 0x0b, 0x2d, 0xa7, 0x42, 0xc9, 0x07, 0xa5, 0xff, 0x7c, 0xb9, 0xab, 0xe8, 0xff, 0x53, 0x40,
+	0xe5, 0xa3, 0xd8, 0x45, 0x0f, 0xad, 0x0e, 0x43, 0x5b, 0xdc, 0xeb, 0xf4, 0x93, 0x58, 0xf2, 0xfa,
+	0x0b, 0x79, 0xf9, 0x90, 0x45, 0xaf, 0x98, 0x8b, 0x5e, 0x66, 0xa6, 0x2b, 0x0d, 0xcd, 0x74, 0xc3,
+	0x28, 0xcc, 0xbe, 0x06, 0x14, 0x22, 0xff, 0xb7, 0x93, 0xe3, 0x74, 0x1a, 0x86, 0x89, 0xe1, 0xed,
+	0xe4, 0x8e, 0xdb, 0xbc, 0x7f, 0x9c, 0xbe, 0xff, 0xdf, 0xe7, 0xbb, 0x0f, 0x13, 0x56, 0x31, 0x1e,
+	0x79, 0xbe, 0x1b, 0xb0, 0xe4, 0x5f, 0xcf, 0x6d, 0xd2, 0x5a, 0xb3, 0xcf, 0x90, 0x56, 0x1f, 0x61,
+	0xef, 0x34, 0xfa, 0x33, 0xfd, 0xa0, 0x5e, 0x9c, 0x66, 0x50, 0x97, 0xb8, 0x97, 0xf2, 0x70, 0xd7,
+	0x7f, 0x5b, 0x00, 0xf5, 0xc2, 0x38, 0x3b, 0x7e, 0x70, 0x8e, 0x6d, 0x8f, 0xf4, 0xa7, 0x76, 0xfc,
+	0x2d, 0x58, 0x12, 0xf0, 0x36, 0xc4, 0x83, 0x4b, 0x64, 0xf5, 0xa2, 0xa0, 0x9d, 0x47, 0xa4, 0x9c,
+	0x18, 0x2a, 0xe6, 0xc5, 0x50, 0xd4, 0x7a, 0x43, 0xeb, 0xf8, 0x41, 0x23, 0x30, 0x7d, 0x94, 0xd9,
+	0xba, 0xc0, 0x29, 0x1f, 0x9b, 0x3e, 0x3f, 0x48, 0xb0, 0x69, 0xdf, 0x6f, 0x12, 0x4f, 0x66, 0xe9,
+	0x22, 0xa7, 0xd5, 0x39, 0x29, 0x3a, 0x48, 0x88, 0xd8, 0x68, 0xb9, 0xbe, 0xe9, 0x51, 0x99, 0xa1,
+	0xcb, 0x9c, 0x7a, 0x2e, 0x89, 0x79, 0x98, 0xcc, 0xe7, 0x62, 0xf2, 0x37, 0x05, 0xca, 0x89, 0xb9,
+	0xff, 0x86, 0x21, 0x71, 0x08, 0x1b, 0x89, 0x97, 0x01, 0xeb, 0xa5, 0x72, 0x63, 0x8d, 0x5e, 0xeb,
+	0xbd, 0x61, 0x86, 0x3c, 0x84, 0x79, 0x1f, 0xfd, 0x26, 0x86, 0x51, 0x0b, 0x88, 0x86, 0x24, 0x2d,
+	0x6f, 0x48, 0x12, 0x76, 0x1b, 0xb1, 0xe8, 0xf1, 0x5f, 0x6f, 0x41, 0x31, 0xea, 0x00, 0x4f, 0x61,
+	0x25, 0xf3, 0x16, 0xbf, 0x93, 0xdc, 0x3e, 0xf4, 0xc8, 0xd7, 0xee, 0x8e, 0x65, 0x0f, 0xda, 0xe5,
+	0x8c, 0xea, 0xc0, 0x46, 0xce, 0xf7, 0x01, 0x55, 0x1f, 0xbb, 0x9f, 0xcb, 0x68, 0xef, 0x4e, 0x96,
+	0x49, 0x1c, 0xf4, 0x19, 0x6c, 0xe6, 0x7e, 0x54, 0xd8, 0xcf, 0x68, 0xc9, 0x13, 0xd2, 0xee, 0x4f,
+	0x21, 0x94, 0x38, 0xeb, 0x29, 0xac, 0x64, 0x3e, 0x2d, 0x64, 0xe1, 0x4a, 0xb3, 0xb5, 0xbb, 0x63,
+	0xd9, 0x09, 0xcd, 0x3f, 0x57, 0x60, 0x67, 0xec, 0x47, 0x85, 0xac, 0xa5, 0xe3, 0x84, 0xb5, 0xf7,
+	0x6e, 0x20, 0x9c, 0xb9, 0xb3, 0x9c, 0xe7, 0xa1, 0x3e, 0x56, 0x1b, 0x97, 0xd1, 0xde, 0x9d, 0x2c,
+	0x93, 0x38, 0xe8, 0x17, 0x0a, 0xdc, 0x19, 0xff, 0xdc, 0xfb, 0xee, 0x0d, 0x3c, 0xa0, 0xda, 0xc3,
+	0x9b, 0x48, 0xa7, 0x63, 0x27, 0xf7, 0xed, 0xb3, 0x3f, 0xd9, 0x1b, 0xaa, 0xdd, 0x9f, 0x42, 0x28,
+	0x71, 0xd6, 0x27, 0xb0, 0x5a, 0x47, 0x96, 0x9a, 0xfc, 0xdf, 0xcc, 0x68, 0x48, 0x32, 0xb5, 0xfd,
+	0x31, 0xcc, 0x84, 0xda, 0x3e, 0x6c, 0x8f, 0x1e, 0xb7, 0x0f, 0x86, 0xc2, 0x6f, 0x84, 0xa4, 0xf6,
+	0x60, 0x5a, 0xc9, 0xeb, 0xa3, 0x4f, 0x8d, 0xaf, 0x5f, 0x54, 0x94, 0x67, 0x2f, 0x2a, 0xca, 0xbf,
+	0x5f, 0x54, 0x94, 0xdf, 0xbc, 0xac, 0xcc, 0x3c, 0x7b, 0x59, 0x99, 0xf9, 0xe7, 0xcb, 0xca, 0xcc,
+	0x4f, 0xdf, 0x4f, 0xf4, 0xb7, 0x36, 0x3a, 0x4e, 0xff, 0xb3, 0x6e, 0xfc, 0x19, 0xfb, 0x50, 0x4c,
+	0xa8, 0x35, 0x9f, 0xd8, 0x1d, 0x0f, 0x6b, 0xbd, 0x98, 0x2e, 0x7a, 0x71, 0x73, 0x8e, 0xbf, 0x2e,
+	0xdf, 0xfb, 0xff, 0x00, 0xab, 0xa0, 0x12, 0x3f, 0x5f, 0x17, 0x00, 0x00,
+}
+
+func (this *SendToCosmosEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Confirmation != nil {
-		{
-			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
+
+	that1, ok := that.(*SendToCosmosEvent)
+	if !ok {
+		that2, ok := that.(SendToCosmosEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventNonce != that1.EventNonce {
+		return false
+	}
+	if this.TokenContract != that1.TokenContract {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.EthereumSender != that1.EthereumSender {
+		return false
+	}
+	if this.CosmosReceiver != that1.CosmosReceiver {
+		return false
+	}
+	if this.EthereumHeight != that1.EthereumHeight {
+		return false
+	}
+	if !this.ReceivedAmount.Equal(that1.ReceivedAmount) {
+		return false
+	}
+	if !this.EscrowBalance.Equal(that1.EscrowBalance) {
+		return false
+	}
+	return true
 }
 
-func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error)
+	SendToEthereumMulti(ctx context.Context, in *MsgSendToEthereumMulti, opts ...grpc.CallOption) (*MsgSendToEthereumMultiResponse, error)
+	CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error)
+	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
+	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
+	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
+	SubmitEthereumTxConfirmations(ctx context.Context, in *MsgSubmitEthereumTxConfirmations, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationsResponse, error)
+	SubmitEthereumEvents(ctx context.Context, in *MsgSubmitEthereumEvents, opts ...grpc.CallOption) (*MsgSubmitEthereumEventsResponse, error)
+	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+	ResolveQuarantinedDeposit(ctx context.Context, in *MsgResolveQuarantinedDeposit, opts ...grpc.CallOption) (*MsgResolveQuarantinedDepositResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error) {
+	out := new(MsgSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SendToEthereumMulti(ctx context.Context, in *MsgSendToEthereumMulti, opts ...grpc.CallOption) (*MsgSendToEthereumMultiResponse, error) {
+	out := new(MsgSendToEthereumMultiResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereumMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error) {
+	out := new(MsgCancelSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEthereum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
+	out := new(MsgRequestBatchTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error) {
+	out := new(MsgSubmitEthereumTxConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error) {
+	out := new(MsgSubmitEthereumEventResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumTxConfirmations(ctx context.Context, in *MsgSubmitEthereumTxConfirmations, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationsResponse, error) {
+	out := new(MsgSubmitEthereumTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumEvents(ctx context.Context, in *MsgSubmitEthereumEvents, opts ...grpc.CallOption) (*MsgSubmitEthereumEventsResponse, error) {
+	out := new(MsgSubmitEthereumEventsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
+	out := new(MsgDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetDelegateKeys", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResolveQuarantinedDeposit(ctx context.Context, in *MsgResolveQuarantinedDeposit, opts ...grpc.CallOption) (*MsgResolveQuarantinedDepositResponse, error) {
+	out := new(MsgResolveQuarantinedDepositResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ResolveQuarantinedDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
+	SendToEthereumMulti(context.Context, *MsgSendToEthereumMulti) (*MsgSendToEthereumMultiResponse, error)
+	CancelSendToEthereum(context.Context, *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error)
+	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
+	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
+	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
+	SubmitEthereumTxConfirmations(context.Context, *MsgSubmitEthereumTxConfirmations) (*MsgSubmitEthereumTxConfirmationsResponse, error)
+	SubmitEthereumEvents(context.Context, *MsgSubmitEthereumEvents) (*MsgSubmitEthereumEventsResponse, error)
+	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+	ResolveQuarantinedDeposit(context.Context, *MsgResolveQuarantinedDeposit) (*MsgResolveQuarantinedDepositResponse, error)
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SendToEthereum(ctx context.Context, req *MsgSendToEthereum) (*MsgSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereum not implemented")
 }
-
-func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SendToEthereumMulti(ctx context.Context, req *MsgSendToEthereumMulti) (*MsgSendToEthereumMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereumMulti not implemented")
 }
-
-func (m *MsgSubmitEthereumEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CancelSendToEthereum(ctx context.Context, req *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEthereum not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumTxConfirmation(ctx context.Context, req *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvent not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumTxConfirmations(ctx context.Context, req *MsgSubmitEthereumTxConfirmations) (*MsgSubmitEthereumTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmations not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumEvents(ctx context.Context, req *MsgSubmitEthereumEvents) (*MsgSubmitEthereumEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvents not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) ResolveQuarantinedDeposit(ctx context.Context, req *MsgResolveQuarantinedDeposit) (*MsgResolveQuarantinedDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveQuarantinedDeposit not implemented")
 }
 
-func (m *MsgSubmitEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSubmitEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_SendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SendToEthereum(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToEthereum(ctx, req.(*MsgSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitEthereumEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SendToEthereumMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToEthereumMulti)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SendToEthereumMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SendToEthereumMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToEthereumMulti(ctx, req.(*MsgSendToEthereumMulti))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSendToEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/CancelSendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSendToEthereum(ctx, req.(*MsgCancelSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatchTx)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RequestBatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitEthereumTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumTxConfirmation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, req.(*MsgSubmitEthereumTxConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthSignature) > 0 {
-		i -= len(m.EthSignature)
-		copy(dAtA[i:], m.EthSignature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_SubmitEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumEvent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumEvent(ctx, in)
 	}
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvent",
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumEvent(ctx, req.(*MsgSubmitEthereumEvent))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitEthereumTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumTxConfirmations)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumTxConfirmations(ctx, req.(*MsgSubmitEthereumTxConfirmations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitEthereumEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumEvents(ctx, req.(*MsgSubmitEthereumEvents))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SetDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+func _Msg_ResolveQuarantinedDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveQuarantinedDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveQuarantinedDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ResolveQuarantinedDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveQuarantinedDeposit(ctx, req.(*MsgResolveQuarantinedDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendToEthereum",
+			Handler:    _Msg_SendToEthereum_Handler,
+		},
+		{
+			MethodName: "SendToEthereumMulti",
+			Handler:    _Msg_SendToEthereumMulti_Handler,
+		},
+		{
+			MethodName: "CancelSendToEthereum",
+			Handler:    _Msg_CancelSendToEthereum_Handler,
+		},
+		{
+			MethodName: "RequestBatchTx",
+			Handler:    _Msg_RequestBatchTx_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumTxConfirmation",
+			Handler:    _Msg_SubmitEthereumTxConfirmation_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumEvent",
+			Handler:    _Msg_SubmitEthereumEvent_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumTxConfirmations",
+			Handler:    _Msg_SubmitEthereumTxConfirmations_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumEvents",
+			Handler:    _Msg_SubmitEthereumEvents_Handler,
+		},
+		{
+			MethodName: "SetDelegateKeys",
+			Handler:    _Msg_SetDelegateKeys_Handler,
+		},
+		{
+			MethodName: "ResolveQuarantinedDeposit",
+			Handler:    _Msg_ResolveQuarantinedDeposit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/msgs.proto",
+}
+
+func (m *MsgSendToEthereum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2202,32 +2494,54 @@ func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2237,61 +2551,25 @@ func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthereumMulti) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2301,42 +2579,41 @@ func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMulti) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *SendToEthereumEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2346,42 +2623,47 @@ func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *SendToEthereumEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SendToEthereumEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	i--
+	dAtA[i] = 0x12
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ERC20DeployedEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthereumMultiResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2391,63 +2673,38 @@ func (m *ERC20DeployedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ERC20DeployedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMultiResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ERC20DeployedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthereumMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Erc20Decimals != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Erc20Decimals))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Erc20Symbol) > 0 {
-		i -= len(m.Erc20Symbol)
-		copy(dAtA[i:], m.Erc20Symbol)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Erc20Name) > 0 {
-		i -= len(m.Erc20Name)
-		copy(dAtA[i:], m.Erc20Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CosmosDenom) > 0 {
-		i -= len(m.CosmosDenom)
-		copy(dAtA[i:], m.CosmosDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintMsgs(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2457,451 +2714,3064 @@ func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SignerSetTxNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+
+func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToEthereumResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatchTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Confirmation != nil {
-		l = m.Confirmation.Size()
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitEthereumEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitEthereumEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeys) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumTxConfirmations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EthSignature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysSignMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthereumTxConfirmationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumTxConfirmationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumTxConfirmationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
+	if len(m.StoreIndex) > 0 {
+		i -= len(m.StoreIndex)
+		copy(dAtA[i:], m.StoreIndex)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.StoreIndex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SendToCosmosEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitEthereumEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthereumEventVoteResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventVoteResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventVoteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.Votes != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ERC20DeployedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.CosmosDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Erc20Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Erc20Symbol)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Erc20Decimals != 0 {
-		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveQuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveQuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveQuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.EthereumSignature) > 0 {
+		i -= len(m.EthereumSignature)
+		copy(dAtA[i:], m.EthereumSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTxExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResolveQuarantinedDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveQuarantinedDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveQuarantinedDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositResolutionSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositResolutionSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositResolutionSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.SignerSetTxNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20DeployedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20DeployedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20DeployedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Erc20Symbol) > 0 {
+		i -= len(m.Erc20Symbol)
+		copy(dAtA[i:], m.Erc20Symbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Erc20Name) > 0 {
+		i -= len(m.Erc20Name)
+		copy(dAtA[i:], m.Erc20Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignerSetTxNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSendToEthereumMulti) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendToEthereumEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSendToEthereumMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEthereumEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumTxConfirmationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreIndex)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumEventVoteResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovMsgs(uint64(m.Votes))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveQuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveQuarantinedDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DepositResolutionSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *SendToCosmosEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *BatchExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *ContractCallExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *ERC20DeployedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *SignerSetTxExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.SignerSetTxNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumMulti) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumMulti: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumMulti: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, SendToEthereumEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumMultiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumMultiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmation == nil {
+				m.Confirmation = &types1.Any{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2924,15 +5794,15 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2960,11 +5830,30 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2992,13 +5881,119 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3008,30 +6003,29 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3041,23 +6035,24 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3084,7 +6079,7 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3107,31 +6102,12 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3156,7 +6132,7 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3179,17 +6155,17 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3199,14 +6175,31 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3234,7 +6227,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3260,7 +6253,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3283,10 +6276,10 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3313,7 +6306,7 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumTxConfirmations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,17 +6329,17 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3356,23 +6349,25 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Confirmations = append(m.Confirmations, &types1.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3430,7 +6425,7 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3453,12 +6448,46 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, EthereumTxConfirmationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3483,7 +6512,7 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *EthereumTxConfirmationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3506,17 +6535,17 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumTxConfirmationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumTxConfirmationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreIndex", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3526,31 +6555,29 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Confirmation == nil {
-				m.Confirmation = &types1.Any{}
-			}
-			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StoreIndex = append(m.StoreIndex[:0], dAtA[iNdEx:postIndex]...)
+			if m.StoreIndex == nil {
+				m.StoreIndex = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3578,7 +6605,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3604,7 +6631,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthereumEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3627,17 +6654,17 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthereumEvents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthereumEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3647,48 +6674,29 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			m.Events = append(m.Events, &types1.Any{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3716,13 +6724,66 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3732,24 +6793,24 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Results = append(m.Results, EthereumEventVoteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3776,7 +6837,7 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *EthereumEventVoteResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,17 +6860,17 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumEventVoteResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumEventVoteResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3819,29 +6880,16 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
 			}
-			m.BatchNonce = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3851,16 +6899,31 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventHash = append(m.EventHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventHash == nil {
+				m.EventHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			m.Votes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3870,29 +6933,16 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Votes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3902,26 +6952,12 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
+			m.Accepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3946,7 +6982,7 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3969,17 +7005,17 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.SignerSetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3989,14 +7025,27 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4024,11 +7073,43 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4055,9 +7136,9 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4084,7 +7165,7 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4107,10 +7188,10 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4128,49 +7209,100 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveQuarantinedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveQuarantinedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveQuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4180,29 +7312,27 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types1.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EthereumSignature = append(m.EthereumSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumSignature == nil {
+				m.EthereumSignature = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4258,7 +7388,7 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveQuarantinedDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4281,10 +7411,10 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveQuarantinedDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveQuarantinedDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4311,7 +7441,7 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+func (m *DepositResolutionSignMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4334,17 +7464,17 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositResolutionSignMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositResolutionSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4354,27 +7484,14 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4402,11 +7519,11 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4434,13 +7551,13 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.GravityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4450,25 +7567,23 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EthSignature == nil {
-				m.EthSignature = []byte{}
-			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4494,59 +7609,6 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4848,6 +7910,74 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4990,6 +8120,40 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
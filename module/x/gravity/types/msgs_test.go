@@ -78,3 +78,230 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestValidateMsgSendToEthereumMulti(t *testing.T) {
+	var (
+		sender    sdk.AccAddress = bytes.Repeat([]byte{0x1}, app.MaxAddrLen)
+		recipient                = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		entry                    = types.SendToEthereumEntry{
+			EthereumRecipient: recipient,
+			Amount:            sdk.NewInt64Coin("stake", 100),
+			BridgeFee:         sdk.NewInt64Coin("stake", 1),
+		}
+	)
+	specs := map[string]struct {
+		sender  sdk.AccAddress
+		entries func() []types.SendToEthereumEntry
+		expErr  bool
+	}{
+		"all good": {
+			sender:  sender,
+			entries: func() []types.SendToEthereumEntry { return []types.SendToEthereumEntry{entry, entry} },
+		},
+		"empty sender": {
+			entries: func() []types.SendToEthereumEntry { return []types.SendToEthereumEntry{entry} },
+			expErr:  true,
+		},
+		"no entries": {
+			sender:  sender,
+			entries: func() []types.SendToEthereumEntry { return nil },
+			expErr:  true,
+		},
+		"fee of another denom": {
+			sender: sender,
+			entries: func() []types.SendToEthereumEntry {
+				other := entry
+				other.BridgeFee = sdk.NewInt64Coin("other", 1)
+				return []types.SendToEthereumEntry{entry, other}
+			},
+			expErr: true,
+		},
+		"entries of different denoms": {
+			sender: sender,
+			entries: func() []types.SendToEthereumEntry {
+				other := entry
+				other.Amount = sdk.NewInt64Coin("other", 100)
+				other.BridgeFee = sdk.NewInt64Coin("other", 1)
+				return []types.SendToEthereumEntry{entry, other}
+			},
+			expErr: true,
+		},
+		"invalid recipient": {
+			sender: sender,
+			entries: func() []types.SendToEthereumEntry {
+				other := entry
+				other.EthereumRecipient = "0x1"
+				return []types.SendToEthereumEntry{entry, other}
+			},
+			expErr: true,
+		},
+		"max entries": {
+			sender: sender,
+			entries: func() []types.SendToEthereumEntry {
+				entries := make([]types.SendToEthereumEntry, types.MaxSendToEthereumEntries)
+				for i := range entries {
+					entries[i] = entry
+				}
+				return entries
+			},
+		},
+		"too many entries": {
+			sender: sender,
+			entries: func() []types.SendToEthereumEntry {
+				entries := make([]types.SendToEthereumEntry, types.MaxSendToEthereumEntries+1)
+				for i := range entries {
+					entries[i] = entry
+				}
+				return entries
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := types.NewMsgSendToEthereumMulti(spec.sender, spec.entries()).ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgSubmitEthereumEvents(t *testing.T) {
+	var signer sdk.AccAddress = bytes.Repeat([]byte{0x1}, app.MaxAddrLen)
+	event := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+			Amount:         sdk.NewInt(1000),
+			EthereumSender: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+			CosmosReceiver: signer.String(),
+			EthereumHeight: 200,
+		}
+	}
+	events := func(first uint64, n int) []types.EthereumEvent {
+		var events []types.EthereumEvent
+		for i := 0; i < n; i++ {
+			events = append(events, event(first+uint64(i)))
+		}
+		return events
+	}
+	specs := map[string]struct {
+		signer sdk.AccAddress
+		events []types.EthereumEvent
+		expErr bool
+	}{
+		"all good": {
+			signer: signer,
+			events: []types.EthereumEvent{event(4), event(5), event(6)},
+		},
+		"empty signer": {
+			events: []types.EthereumEvent{event(4)},
+			expErr: true,
+		},
+		"no events": {
+			signer: signer,
+			expErr: true,
+		},
+		"non contiguous nonces": {
+			signer: signer,
+			events: []types.EthereumEvent{event(4), event(6)},
+			expErr: true,
+		},
+		"invalid event": {
+			signer: signer,
+			events: []types.EthereumEvent{event(4), &types.SendToCosmosEvent{EventNonce: 5}},
+			expErr: true,
+		},
+		"max events": {
+			signer: signer,
+			events: events(1, types.MaxEthereumEventsPerMsg),
+		},
+		"too many events": {
+			signer: signer,
+			events: events(1, types.MaxEthereumEventsPerMsg+1),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := &types.MsgSubmitEthereumEvents{Signer: spec.signer.String()}
+			for _, event := range spec.events {
+				any, err := types.PackEvent(event)
+				assert.NoError(t, err)
+				m.Events = append(m.Events, any)
+			}
+			err := m.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgSubmitEthereumTxConfirmations(t *testing.T) {
+	var signer sdk.AccAddress = bytes.Repeat([]byte{0x1}, app.MaxAddrLen)
+	confirmations := func(first uint64, n int) []types.EthereumTxConfirmation {
+		var confirmations []types.EthereumTxConfirmation
+		for i := 0; i < n; i++ {
+			confirmations = append(confirmations, &types.SignerSetTxConfirmation{
+				SignerSetNonce: first + uint64(i),
+				EthereumSigner: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+				Signature:      []byte("signature"),
+			})
+		}
+		return confirmations
+	}
+	specs := map[string]struct {
+		signer        sdk.AccAddress
+		confirmations []types.EthereumTxConfirmation
+		expErr        bool
+	}{
+		"all good": {
+			signer:        signer,
+			confirmations: confirmations(1, 3),
+		},
+		"empty signer": {
+			confirmations: confirmations(1, 1),
+			expErr:        true,
+		},
+		"no confirmations": {
+			signer: signer,
+			expErr: true,
+		},
+		"duplicate confirmation": {
+			signer:        signer,
+			confirmations: append(confirmations(1, 2), confirmations(2, 1)...),
+			expErr:        true,
+		},
+		"max confirmations": {
+			signer:        signer,
+			confirmations: confirmations(1, types.MaxEthereumTxConfirmationsPerMsg),
+		},
+		"too many confirmations": {
+			signer:        signer,
+			confirmations: confirmations(1, types.MaxEthereumTxConfirmationsPerMsg+1),
+			expErr:        true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := &types.MsgSubmitEthereumTxConfirmations{Signer: spec.signer.String()}
+			for _, confirmation := range spec.confirmations {
+				any, err := types.PackConfirmation(confirmation)
+				assert.NoError(t, err)
+				m.Confirmations = append(m.Confirmations, any)
+			}
+			err := m.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeResolveQuarantinedDeposit defines the type for a ResolveQuarantinedDepositProposal
+	ProposalTypeResolveQuarantinedDeposit = "ResolveQuarantinedDeposit"
+	// ProposalTypeSetERC20TokenMetadata defines the type for a SetERC20TokenMetadataProposal
+	ProposalTypeSetERC20TokenMetadata = "SetERC20TokenMetadata"
+	// ProposalTypeApproveCosmosERC20 defines the type for a ApproveCosmosERC20Proposal
+	ProposalTypeApproveCosmosERC20 = "ApproveCosmosERC20"
+)
+
+var (
+	_ govtypes.Content = &ResolveQuarantinedDepositProposal{}
+	_ govtypes.Content = &SetERC20TokenMetadataProposal{}
+	_ govtypes.Content = &ApproveCosmosERC20Proposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeResolveQuarantinedDeposit)
+	govtypes.RegisterProposalTypeCodec(&ResolveQuarantinedDepositProposal{}, "gravity/ResolveQuarantinedDepositProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetERC20TokenMetadata)
+	govtypes.RegisterProposalTypeCodec(&SetERC20TokenMetadataProposal{}, "gravity/SetERC20TokenMetadataProposal")
+	govtypes.RegisterProposalType(ProposalTypeApproveCosmosERC20)
+	govtypes.RegisterProposalTypeCodec(&ApproveCosmosERC20Proposal{}, "gravity/ApproveCosmosERC20Proposal")
+}
+
+// NewResolveQuarantinedDepositProposal returns a new ResolveQuarantinedDepositProposal
+func NewResolveQuarantinedDepositProposal(title, description string, eventNonce uint64, cosmosReceiver string) *ResolveQuarantinedDepositProposal {
+	return &ResolveQuarantinedDepositProposal{
+		Title:          title,
+		Description:    description,
+		EventNonce:     eventNonce,
+		CosmosReceiver: cosmosReceiver,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *ResolveQuarantinedDepositProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *ResolveQuarantinedDepositProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ResolveQuarantinedDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ResolveQuarantinedDepositProposal) ProposalType() string {
+	return ProposalTypeResolveQuarantinedDeposit
+}
+
+// ValidateBasic performs stateless checks
+func (p *ResolveQuarantinedDepositProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce cannot be 0")
+	}
+	if p.CosmosReceiver != "" {
+		if _, err := sdk.AccAddressFromBech32(p.CosmosReceiver); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.CosmosReceiver)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p ResolveQuarantinedDepositProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Resolve Quarantined Deposit Proposal:
+  Title:           %s
+  Description:     %s
+  Event Nonce:     %d
+  Cosmos Receiver: %s
+`, p.Title, p.Description, p.EventNonce, p.CosmosReceiver))
+	return b.String()
+}
+
+// NewSetERC20TokenMetadataProposal returns a new SetERC20TokenMetadataProposal
+func NewSetERC20TokenMetadataProposal(title, description string, token ERC20TokenMetadata) *SetERC20TokenMetadataProposal {
+	return &SetERC20TokenMetadataProposal{
+		Title:         title,
+		Description:   description,
+		TokenContract: token.TokenContract,
+		Name:          token.Name,
+		Symbol:        token.Symbol,
+		Decimals:      token.Decimals,
+		Allowed:       token.Allowed,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *SetERC20TokenMetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *SetERC20TokenMetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *SetERC20TokenMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetERC20TokenMetadataProposal) ProposalType() string {
+	return ProposalTypeSetERC20TokenMetadata
+}
+
+// ERC20TokenMetadata returns the token registry entry the proposal sets
+func (p *SetERC20TokenMetadataProposal) ERC20TokenMetadata() ERC20TokenMetadata {
+	return ERC20TokenMetadata{
+		TokenContract: p.TokenContract,
+		Name:          p.Name,
+		Symbol:        p.Symbol,
+		Decimals:      p.Decimals,
+		Allowed:       p.Allowed,
+	}
+}
+
+// ValidateBasic performs stateless checks
+func (p *SetERC20TokenMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.ERC20TokenMetadata().ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p SetERC20TokenMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set ERC20 Token Metadata Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Name:           %s
+  Symbol:         %s
+  Decimals:       %d
+  Allowed:        %t
+`, p.Title, p.Description, p.TokenContract, p.Name, p.Symbol, p.Decimals, p.Allowed))
+	return b.String()
+}
+
+// NewApproveCosmosERC20Proposal returns a new ApproveCosmosERC20Proposal
+func NewApproveCosmosERC20Proposal(title, description, denom, tokenContract string) *ApproveCosmosERC20Proposal {
+	return &ApproveCosmosERC20Proposal{
+		Title:         title,
+		Description:   description,
+		Denom:         denom,
+		TokenContract: tokenContract,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *ApproveCosmosERC20Proposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *ApproveCosmosERC20Proposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ApproveCosmosERC20Proposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ApproveCosmosERC20Proposal) ProposalType() string {
+	return ProposalTypeApproveCosmosERC20
+}
+
+// ValidateBasic performs stateless checks
+func (p *ApproveCosmosERC20Proposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return CosmosERC20Approval{Denom: p.Denom, TokenContract: p.TokenContract}.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p ApproveCosmosERC20Proposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Approve Cosmos ERC20 Proposal:
+  Title:          %s
+  Description:    %s
+  Denom:          %s
+  Token Contract: %s
+`, p.Title, p.Description, p.Denom, p.TokenContract))
+	return b.String()
+}
@@ -23,6 +23,15 @@ func (b *EthereumSigner) ValidateBasic() error {
 	return nil
 }
 
+// EthereumSignerPowerThreshold is the power of the signers the Gravity
+// contract requires to accept a signed update, out of math.MaxUint32
+const EthereumSignerPowerThreshold uint64 = 2834678415
+
+// EthereumSignerPowerMargin is the power above EthereumSignerPowerThreshold a
+// capped signer set keeps, about 5% out of math.MaxUint32, so that the bridge
+// doesn't halt as soon as one of its signers is offline
+const EthereumSignerPowerMargin uint64 = 214748364
+
 // EthereumSigners is the sorted set of validator data for Ethereum bridge MultiSig set
 type EthereumSigners []*EthereumSigner
 
@@ -88,6 +97,24 @@ func (b EthereumSigners) PowerDiff(c EthereumSigners) float64 {
 	return math.Abs(delta / float64(math.MaxUint32))
 }
 
+// HasSameMembers returns true if both sets have the same ethereum addresses,
+// whatever their powers
+func (b EthereumSigners) HasSameMembers(c EthereumSigners) bool {
+	if len(b) != len(c) {
+		return false
+	}
+	members := make(map[common.Address]bool, len(b))
+	for _, bv := range b {
+		members[common.HexToAddress(bv.EthereumAddress)] = true
+	}
+	for _, cv := range c {
+		if !members[common.HexToAddress(cv.EthereumAddress)] {
+			return false
+		}
+	}
+	return true
+}
+
 // TotalPower returns the total power in the bridge validator set
 func (b EthereumSigners) TotalPower() (out uint64) {
 	for _, v := range b {
@@ -123,3 +150,13 @@ func (b BatchTx) GetFees() sdk.Int {
 	}
 	return sum
 }
+
+// GetTotal returns the total amount, including fees, leaving the bridge
+// contract when a given batch is executed
+func (b BatchTx) GetTotal() sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, t := range b.Transactions {
+		sum = sum.Add(t.Erc20Token.Amount).Add(t.Erc20Fee.Amount)
+	}
+	return sum
+}
@@ -6,6 +6,7 @@ import (
 	mrand "math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -172,3 +173,24 @@ func shuffled(v EthereumSigners) EthereumSigners {
 	})
 	return v
 }
+
+func TestSendToCosmosEventHashBalances(t *testing.T) {
+	event := func(received, escrow sdk.Int) *SendToCosmosEvent {
+		return &SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(0x0203),
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			EthereumHeight: 10,
+			ReceivedAmount: received,
+			EscrowBalance:  escrow,
+		}
+	}
+
+	// the balances can't be split differently into the same hash
+	assert.NotEqual(t, event(sdk.NewInt(0x01), sdk.NewInt(0x0203)).Hash(), event(sdk.NewInt(0x0102), sdk.NewInt(0x03)).Hash())
+	// an unreported balance differs from a reported zero balance
+	assert.NotEqual(t, event(sdk.Int{}, sdk.NewInt(0x01)).Hash(), event(sdk.NewInt(0x01), sdk.Int{}).Hash())
+	assert.NotEqual(t, event(sdk.Int{}, sdk.Int{}).Hash(), event(sdk.ZeroInt(), sdk.ZeroInt()).Hash())
+}
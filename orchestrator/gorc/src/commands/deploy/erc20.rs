@@ -104,7 +104,7 @@ impl Erc20 {
 
             if Instant::now() - start > Duration::from_secs(100) {
                 println!(
-                    "Your ERC20 contract was not adopted, double check the metadata and try again"
+                    "Your ERC20 contract was not adopted, double check the metadata or have governance approve it with an approve-cosmos-erc20 proposal"
                 );
                 exit(1);
             }